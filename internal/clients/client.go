@@ -3,6 +3,7 @@ package clients
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/validation"
@@ -32,6 +33,7 @@ import (
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
+	chaosstudio "github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/client"
 	cognitiveServices "github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive/client"
 	communication "github.com/hashicorp/terraform-provider-azurerm/internal/services/communication/client"
 	compute "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
@@ -138,6 +140,10 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// options and subscriptionClients are used to build Clients for other Subscriptions on demand
+	options             *common.ClientOptions
+	subscriptionClients *subscriptionClientCache
+
 	AadB2c                *aadb2c_v2021_04_01_preview.Client
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisservices_v2017_08_01.Client
@@ -154,6 +160,7 @@ type Client struct {
 	Blueprints            *blueprints.Client
 	Bot                   *bot.Client
 	Cdn                   *cdn.Client
+	ChaosStudio           *chaosstudio.Client
 	Cognitive             *cognitiveServices.Client
 	Communication         *communication.Client
 	Compute               *compute.Client
@@ -261,6 +268,12 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 
 	client.Features = o.Features
 	client.StopContext = ctx
+	client.options = o
+	client.subscriptionClients = &subscriptionClientCache{
+		clients: map[string]*Client{
+			strings.ToLower(o.SubscriptionId): client,
+		},
+	}
 
 	var err error
 
@@ -269,7 +282,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	}
 	client.Advisor = advisor.NewClient(o)
 	client.AnalysisServices = analysisServices.NewClient(o)
-	client.ApiManagement = apiManagement.NewClient(o)
+	if client.ApiManagement, err = apiManagement.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ApiManagement: %+v", err)
+	}
 	client.AppConfiguration = appConfiguration.NewClient(o)
 	client.AppInsights = applicationInsights.NewClient(o)
 	client.AppPlatform = appPlatform.NewClient(o)
@@ -282,6 +297,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Blueprints = blueprints.NewClient(o)
 	client.Bot = bot.NewClient(o)
 	client.Cdn = cdn.NewClient(o)
+	if client.ChaosStudio, err = chaosstudio.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for ChaosStudio: %+v", err)
+	}
 	client.Cognitive = cognitiveServices.NewClient(o)
 	client.Communication = communication.NewClient(o)
 	client.Compute = compute.NewClient(o)
@@ -290,7 +308,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Consumption = consumption.NewClient(o)
 	client.Containers = containerServices.NewContainersClient(o)
 	client.ContainerApps = containerapps.NewClient(o)
-	client.Cosmos = cosmosdb.NewClient(o)
+	if client.Cosmos, err = cosmosdb.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Cosmos: %+v", err)
+	}
 	client.CostManagement = costmanagement.NewClient(o)
 	client.CustomProviders = customproviders.NewClient(o)
 	client.Dashboard = dashboard.NewClient(o)
@@ -310,7 +330,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	}
 	client.DomainServices = domainservices.NewClient(o)
 	client.Elastic = elastic.NewClient(o)
-	client.EventGrid = eventgrid.NewClient(o)
+	if client.EventGrid, err = eventgrid.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for EventGrid: %+v", err)
+	}
 	client.Eventhub = eventhub.NewClient(o)
 	client.Firewall = firewall.NewClient(o)
 	client.FluidRelay = fluidrelay.NewClient(o)
@@ -342,7 +364,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.MariaDB = mariadb.NewClient(o)
 	client.Media = media.NewClient(o)
 	client.MixedReality = mixedreality.NewClient(o)
-	client.Monitor = monitor.NewClient(o)
+	if client.Monitor, err = monitor.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Monitor: %+v", err)
+	}
 	client.MobileNetwork = mobilenetwork.NewClient(o)
 	client.MSSQL = mssql.NewClient(o)
 	client.MySQL = mysql.NewClient(o)
@@ -365,7 +389,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	client.Resource = resource.NewClient(o)
 	client.Search = search.NewClient(o)
 	client.SecurityCenter = securityCenter.NewClient(o)
-	client.Sentinel = sentinel.NewClient(o)
+	if client.Sentinel, err = sentinel.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Sentinel: %+v", err)
+	}
 	client.ServiceBus = serviceBus.NewClient(o)
 	client.ServiceConnector = serviceConnector.NewClient(o)
 	client.ServiceFabric = serviceFabric.NewClient(o)
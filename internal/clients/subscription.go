@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// subscriptionClientCache holds the Clients which have been built for Subscriptions other than
+// the one the Provider is configured for, so that each is only built once per Provider instance
+type subscriptionClientCache struct {
+	lock    sync.Mutex
+	clients map[string]*Client
+}
+
+// ClientForSubscription returns a Client whose management-plane clients target the specified Subscription,
+// using the same credentials, environment and features as this Client. Since the same credentials are used the
+// Subscription must be within the Tenant this Client was built for, Subscriptions in other Tenants aren't supported.
+//
+// The Client for each Subscription is built the first time it's requested and is then cached - when the
+// Subscription ID is empty or matches the Subscription the Provider is configured for, this Client is returned.
+func (client *Client) ClientForSubscription(subscriptionId string) (*Client, error) {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+
+	if client.options == nil || client.subscriptionClients == nil {
+		return nil, fmt.Errorf("building a client for Subscription %q: the client has not been built", subscriptionId)
+	}
+
+	cache := client.subscriptionClients
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if existing, ok := cache.clients[key]; ok {
+		return existing, nil
+	}
+
+	account := *client.Account
+	account.SubscriptionId = subscriptionId
+
+	options := *client.options
+	options.SubscriptionId = subscriptionId
+
+	subscriptionClient := &Client{
+		Account: &account,
+	}
+	if err := subscriptionClient.Build(client.StopContext, &options); err != nil {
+		return nil, fmt.Errorf("building a client for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	// Clients built for a Subscription share the cache of the Client they were built from
+	subscriptionClient.subscriptionClients = cache
+
+	cache.clients[key] = subscriptionClient
+	return subscriptionClient, nil
+}
@@ -0,0 +1,100 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+func TestClientForSubscription(t *testing.T) {
+	client := buildTestClient(t, "00000000-0000-0000-0000-000000000000")
+
+	// an empty or matching Subscription ID returns the existing Client
+	for _, subscriptionId := range []string{"", "00000000-0000-0000-0000-000000000000"} {
+		actual, err := client.ClientForSubscription(subscriptionId)
+		if err != nil {
+			t.Fatalf("building a client for Subscription %q: %+v", subscriptionId, err)
+		}
+		if actual != client {
+			t.Fatalf("expected the existing client to be returned for Subscription %q", subscriptionId)
+		}
+	}
+
+	other, err := client.ClientForSubscription("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("building a client for another Subscription: %+v", err)
+	}
+	if other == client {
+		t.Fatalf("expected a new client to be built for another Subscription")
+	}
+	if other.Account.SubscriptionId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the Subscription ID of the new client to be overridden but got %q", other.Account.SubscriptionId)
+	}
+	if other.options.SubscriptionId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("expected the Subscription ID of the new client options to be overridden but got %q", other.options.SubscriptionId)
+	}
+	if client.Account.SubscriptionId != "00000000-0000-0000-0000-000000000000" || client.options.SubscriptionId != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("expected the Subscription ID of the existing client to be unchanged")
+	}
+
+	// subsequent requests for the same Subscription, in any casing, are served from the cache
+	cached, err := client.ClientForSubscription("11111111-1111-1111-1111-111111111111")
+	if err != nil {
+		t.Fatalf("retrieving the cached client: %+v", err)
+	}
+	if cached != other {
+		t.Fatalf("expected the cached client to be returned")
+	}
+
+	// the cache is shared with the Clients built for other Subscriptions
+	root, err := other.ClientForSubscription("00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("retrieving the client for the original Subscription: %+v", err)
+	}
+	if root != client {
+		t.Fatalf("expected the original client to be returned from the shared cache")
+	}
+
+	if len(client.subscriptionClients.clients) != 2 {
+		t.Fatalf("expected 2 clients to be cached but got %d", len(client.subscriptionClients.clients))
+	}
+}
+
+func TestClientForSubscriptionNotBuilt(t *testing.T) {
+	client := &Client{
+		Account: &ResourceManagerAccount{
+			SubscriptionId: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	if _, err := client.ClientForSubscription("11111111-1111-1111-1111-111111111111"); err == nil {
+		t.Fatalf("expected an error when the client has not been built")
+	}
+}
+
+func buildTestClient(t *testing.T, subscriptionId string) *Client {
+	env := environments.AzurePublic()
+	resourceManagerEndpoint, _ := env.ResourceManager.Endpoint()
+
+	client := &Client{
+		Account: &ResourceManagerAccount{
+			Environment:    *env,
+			SubscriptionId: subscriptionId,
+			TenantId:       "22222222-2222-2222-2222-222222222222",
+		},
+	}
+	options := &common.ClientOptions{
+		Authorizers:             &common.Authorizers{},
+		Environment:             *env,
+		SubscriptionId:          subscriptionId,
+		TenantId:                "22222222-2222-2222-2222-222222222222",
+		ResourceManagerEndpoint: *resourceManagerEndpoint,
+	}
+	if err := client.Build(context.Background(), options); err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	return client
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/bot"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cognitive"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/communication"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute"
@@ -125,9 +126,11 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		appconfiguration.Registration{},
 		applicationinsights.Registration{},
 		appservice.Registration{},
+		authorization.Registration{},
 		automation.Registration{},
 		batch.Registration{},
 		bot.Registration{},
+		chaosstudio.Registration{},
 		cognitive.Registration{},
 		compute.Registration{},
 		consumption.Registration{},
@@ -140,6 +143,7 @@ func SupportedTypedServices() []sdk.TypedServiceRegistration {
 		digitaltwins.Registration{},
 		disks.Registration{},
 		domainservices.Registration{},
+		eventgrid.Registration{},
 		eventhub.Registration{},
 		fluidrelay.Registration{},
 		hybridcompute.Registration{},
@@ -0,0 +1,35 @@
+package restore
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// PointInTimeSchema returns the Schema which should be used for the (RFC3339) point in time
+// a new resource is restored to from its source
+func PointInTimeSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:             pluginsdk.TypeString,
+		Optional:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppress.RFC3339Time,
+		ValidateFunc:     validation.IsRFC3339Time,
+	}
+}
+
+// SchemaDataSource returns the Schema which should be used for the restorable timestamps
+// exposed by a Data Source
+func SchemaDataSource() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"earliest_restore_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"latest_restore_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
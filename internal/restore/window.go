@@ -0,0 +1,83 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Window is the range of time which a source can be restored to
+type Window struct {
+	Earliest time.Time
+	Latest   time.Time
+}
+
+// WindowFunc returns the restorable Window for the source with the specified Resource ID
+type WindowFunc func(ctx context.Context, meta interface{}, sourceId string) (*Window, error)
+
+// Validate returns an error when the point in time falls outside of the restorable Window
+func (w Window) Validate(pointInTime time.Time) error {
+	if pointInTime.Before(w.Earliest) {
+		return fmt.Errorf("the point in time %s is before the earliest restore time %s of the source", pointInTime.Format(time.RFC3339), w.Earliest.Format(time.RFC3339))
+	}
+
+	if pointInTime.After(w.Latest) {
+		return fmt.Errorf("the point in time %s is after the latest restore time %s of the source", pointInTime.Format(time.RFC3339), w.Latest.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// Flatten sets the restorable timestamps defined in SchemaDataSource from the Window
+func (w Window) Flatten(d *pluginsdk.ResourceData) error {
+	if err := d.Set("earliest_restore_time", w.Earliest.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("setting `earliest_restore_time`: %+v", err)
+	}
+
+	if err := d.Set("latest_restore_time", w.Latest.Format(time.RFC3339)); err != nil {
+		return fmt.Errorf("setting `latest_restore_time`: %+v", err)
+	}
+
+	return nil
+}
+
+// ValidatePointInTimeInDiff returns a CustomizeDiffFunc which checks at plan time that the point in time
+// specified in `pointInTimeKey` falls within the restorable Window of the source specified in `sourceIdKey`,
+// rather than surfacing the error from the API once the restore has been started.
+//
+// The check is skipped when either value isn't known yet, or when the point in time hasn't changed on an existing resource.
+func ValidatePointInTimeInDiff(sourceIdKey, pointInTimeKey string, windowFunc WindowFunc) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" && !d.HasChange(pointInTimeKey) {
+			return nil
+		}
+
+		if !d.NewValueKnown(sourceIdKey) || !d.NewValueKnown(pointInTimeKey) {
+			return nil
+		}
+
+		sourceId, _ := d.Get(sourceIdKey).(string)
+		pointInTimeRaw, _ := d.Get(pointInTimeKey).(string)
+		if sourceId == "" || pointInTimeRaw == "" {
+			return nil
+		}
+
+		pointInTime, err := time.Parse(time.RFC3339, pointInTimeRaw)
+		if err != nil {
+			return fmt.Errorf("parsing `%s`: %+v", pointInTimeKey, err)
+		}
+
+		window, err := windowFunc(ctx, meta, sourceId)
+		if err != nil {
+			return fmt.Errorf("retrieving the restorable window for %q: %+v", sourceId, err)
+		}
+
+		if err := window.Validate(pointInTime); err != nil {
+			return fmt.Errorf("`%s`: %+v", pointInTimeKey, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,55 @@
+package restore
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWindowValidate(t *testing.T) {
+	window := Window{
+		Earliest: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		Latest:   time.Date(2023, 1, 8, 0, 0, 0, 0, time.UTC),
+	}
+
+	cases := []struct {
+		PointInTime time.Time
+		Valid       bool
+	}{
+		{
+			// before the earliest restore time
+			PointInTime: time.Date(2022, 12, 31, 23, 59, 59, 0, time.UTC),
+			Valid:       false,
+		},
+		{
+			PointInTime: window.Earliest,
+			Valid:       true,
+		},
+		{
+			PointInTime: time.Date(2023, 1, 4, 12, 0, 0, 0, time.UTC),
+			Valid:       true,
+		},
+		{
+			// equivalent time in another time zone
+			PointInTime: time.Date(2023, 1, 4, 12, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			Valid:       true,
+		},
+		{
+			PointInTime: window.Latest,
+			Valid:       true,
+		},
+		{
+			// after the latest restore time
+			PointInTime: time.Date(2023, 1, 8, 0, 0, 1, 0, time.UTC),
+			Valid:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %s", tc.PointInTime.Format(time.RFC3339))
+
+		err := window.Validate(tc.PointInTime)
+		if valid := err == nil; valid != tc.Valid {
+			t.Fatalf("expected %t but got %t for %s: %+v", tc.Valid, valid, tc.PointInTime.Format(time.RFC3339), err)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package apimanagement
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
 	"strings"
@@ -20,6 +23,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// apiManagementApiTypeSyntheticGraphql is a GraphQL API without a backend service, which the API
+// exposes as an API of type `graphql` without a `serviceUrl`
+const apiManagementApiTypeSyntheticGraphql = "synthetic_graphql"
+
 func resourceApiManagementApi() *pluginsdk.Resource {
 	resource := &pluginsdk.Resource{
 		Create: resourceApiManagementApiCreateUpdate,
@@ -97,6 +104,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 					string(apimanagement.APITypeHTTP),
 					string(apimanagement.APITypeSoap),
 					string(apimanagement.APITypeWebsocket),
+					apiManagementApiTypeSyntheticGraphql,
 				}, false),
 			},
 
@@ -147,6 +155,7 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 							Type:     pluginsdk.TypeString,
 							Required: true,
 							ValidateFunc: validation.StringInSlice([]string{
+								string(apimanagement.ContentFormatGraphqlLink),
 								string(apimanagement.ContentFormatOpenapi),
 								string(apimanagement.ContentFormatOpenapijson),
 								string(apimanagement.ContentFormatOpenapijsonLink),
@@ -307,6 +316,39 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"import_content_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"operation": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"operation_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"display_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"method": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"url_template": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"version": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -325,6 +367,34 @@ func resourceApiManagementApi() *pluginsdk.Resource {
 				Optional: true,
 			},
 		},
+
+		// the hash of the imported content is stored so that a change to the specification shows up in the plan
+		// as a re-import, rather than the API being re-imported silently each time any other property changes
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				// APIs created before `import_content_hash` existed have no hash in the state - rather than
+				// re-importing these once, the hash is seeded by the next refresh
+				if oldHash, _ := d.GetChange("import_content_hash"); d.Id() != "" && oldHash.(string) == "" && !d.HasChange("import") {
+					return nil
+				}
+
+				if !d.NewValueKnown("import.0.content_value") || !d.NewValueKnown("import.0.content_format") {
+					return d.SetNewComputed("import_content_hash")
+				}
+
+				hash := ""
+				if vs := d.Get("import").([]interface{}); len(vs) > 0 && vs[0] != nil {
+					importV := vs[0].(map[string]interface{})
+					hash = apiManagementApiImportContentHash(importV)
+				}
+
+				if hash != d.Get("import_content_hash").(string) {
+					return d.SetNew("import_content_hash", hash)
+				}
+
+				return nil
+			},
+		),
 	}
 
 	if !features.FourPointOhBeta() {
@@ -391,16 +461,32 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		}
 	}
 
+	// a synthetic GraphQL API has no backend service, the data is instead provided by its resolvers
+	syntheticGraphql := apiType == apiManagementApiTypeSyntheticGraphql
+	if syntheticGraphql {
+		apiType = apimanagement.APITypeGraphql
+	}
+
+	serviceUrl := d.Get("service_url").(string)
+	if syntheticGraphql && serviceUrl != "" {
+		return fmt.Errorf("`service_url` cannot be specified when `api_type` is `%s`", apiManagementApiTypeSyntheticGraphql)
+	}
+
 	soapApiType := map[apimanagement.APIType]apimanagement.SoapAPIType{
 		apimanagement.APITypeGraphql:   apimanagement.SoapAPITypeGraphQL,
 		apimanagement.APITypeHTTP:      apimanagement.SoapAPITypeSoapToRest,
 		apimanagement.APITypeSoap:      apimanagement.SoapAPITypeSoapPassThrough,
 		apimanagement.APITypeWebsocket: apimanagement.SoapAPITypeWebSocket,
 	}[apiType]
+	if syntheticGraphql {
+		soapApiType = ""
+	}
 
 	// If import is used, we need to send properties to Azure API in two operations.
 	// First we execute import and then updated the other props.
-	if vs, hasImport := d.GetOk("import"); hasImport {
+	// Since an import replaces the operations of the API, it's only run when the API is created or
+	// the imported content changes, rather than each time another property of the API is updated
+	if vs, hasImport := d.GetOk("import"); hasImport && (d.IsNewResource() || d.HasChange("import_content_hash")) {
 		importVs := vs.([]interface{})
 		importV := importVs[0].(map[string]interface{})
 		contentFormat := importV["content_format"].(string)
@@ -439,6 +525,17 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 			apiParams.APICreateOrUpdateProperties.APIVersionSetID = utils.String(versionSetId)
 		}
 
+		// re-importing the specification drops the policies assigned to the existing operations, so these are
+		// retrieved beforehand and re-applied to the operations which are still present afterwards
+		operationPolicies := make(map[string]string)
+		if !d.IsNewResource() {
+			var err error
+			operationPolicies, err = listApiManagementApiOperationPolicies(ctx, meta.(*clients.Client), id, apiId)
+			if err != nil {
+				return err
+			}
+		}
+
 		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, apiParams, "")
 		if err != nil {
 			return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -447,10 +544,13 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
 			return fmt.Errorf("waiting on creating/updating %s: %+v", id, err)
 		}
+
+		if err := restoreApiManagementApiOperationPolicies(ctx, meta.(*clients.Client), id, apiId, operationPolicies); err != nil {
+			return err
+		}
 	}
 
 	description := d.Get("description").(string)
-	serviceUrl := d.Get("service_url").(string)
 	subscriptionRequired := d.Get("subscription_required").(bool)
 
 	subscriptionKeyParameterNamesRaw := d.Get("subscription_key_parameter_names").([]interface{})
@@ -491,6 +591,10 @@ func resourceApiManagementApiCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		},
 	}
 
+	if syntheticGraphql {
+		params.APICreateOrUpdateProperties.ServiceURL = nil
+	}
+
 	if sourceApiId != "" {
 		params.APICreateOrUpdateProperties.SourceAPIID = &sourceApiId
 	}
@@ -557,6 +661,10 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if len(apiType) == 0 {
 			apiType = string(apimanagement.APITypeHTTP)
 		}
+		// both are returned as `graphql`, however only a synthetic GraphQL API has no `serviceUrl`
+		if props.APIType == apimanagement.APITypeGraphql && d.Get("api_type").(string) == apiManagementApiTypeSyntheticGraphql && (props.ServiceURL == nil || *props.ServiceURL == "") {
+			apiType = apiManagementApiTypeSyntheticGraphql
+		}
 		d.Set("api_type", apiType)
 		d.Set("description", props.Description)
 		d.Set("display_name", props.DisplayName)
@@ -600,6 +708,31 @@ func resourceApiManagementApiRead(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	importContentHash := ""
+	operation := make([]interface{}, 0)
+	if vs := d.Get("import").([]interface{}); len(vs) > 0 && vs[0] != nil {
+		importContentHash = apiManagementApiImportContentHash(vs[0].(map[string]interface{}))
+
+		// the operations are only listed for imported APIs, since otherwise they're managed individually
+		operations, err := meta.(*clients.Client).ApiManagement.ApiOperationsClient.ListByAPIComplete(ctx, id.ResourceGroup, id.ServiceName, id.Name, "", nil, nil, "")
+		if err != nil {
+			return fmt.Errorf("listing operations for %s: %+v", *id, err)
+		}
+
+		for operations.NotDone() {
+			operation = append(operation, flattenApiManagementApiOperation(operations.Value()))
+
+			if err := operations.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing operations for %s: %+v", *id, err)
+			}
+		}
+	}
+	d.Set("import_content_hash", importContentHash)
+
+	if err := d.Set("operation", operation); err != nil {
+		return fmt.Errorf("setting `operation`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -630,6 +763,87 @@ func resourceApiManagementApiDelete(d *pluginsdk.ResourceData, meta interface{})
 	return nil
 }
 
+func apiManagementApiImportContentHash(input map[string]interface{}) string {
+	content := fmt.Sprintf("%s\n%s", input["content_format"].(string), input["content_value"].(string))
+	if vs, ok := input["wsdl_selector"].([]interface{}); ok && len(vs) > 0 && vs[0] != nil {
+		wsdlSelector := vs[0].(map[string]interface{})
+		content = fmt.Sprintf("%s\n%s\n%s", content, wsdlSelector["service_name"].(string), wsdlSelector["endpoint_name"].(string))
+	}
+
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
+func listApiManagementApiOperationPolicies(ctx context.Context, client *clients.Client, id parse.ApiId, apiId string) (map[string]string, error) {
+	operations, err := client.ApiManagement.ApiOperationsClient.ListByAPIComplete(ctx, id.ResourceGroup, id.ServiceName, apiId, "", nil, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing operations for %s: %+v", id, err)
+	}
+
+	policies := make(map[string]string)
+	for operations.NotDone() {
+		if operationId := operations.Value().Name; operationId != nil {
+			resp, err := client.ApiManagement.ApiOperationPoliciesClient.Get(ctx, id.ResourceGroup, id.ServiceName, apiId, *operationId, apimanagement.PolicyExportFormatRawxml)
+			if err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return nil, fmt.Errorf("retrieving policy for operation %q of %s: %+v", *operationId, id, err)
+				}
+			} else if props := resp.PolicyContractProperties; props != nil && props.Value != nil {
+				policies[*operationId] = *props.Value
+			}
+		}
+
+		if err := operations.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("listing operations for %s: %+v", id, err)
+		}
+	}
+
+	return policies, nil
+}
+
+func restoreApiManagementApiOperationPolicies(ctx context.Context, client *clients.Client, id parse.ApiId, apiId string, policies map[string]string) error {
+	for operationId, policy := range policies {
+		existing, err := client.ApiManagement.ApiOperationsClient.Get(ctx, id.ResourceGroup, id.ServiceName, apiId, operationId)
+		if err != nil {
+			if utils.ResponseWasNotFound(existing.Response) {
+				log.Printf("[DEBUG] operation %q was removed from %s by the import - not restoring its policy", operationId, id)
+				continue
+			}
+
+			return fmt.Errorf("retrieving operation %q of %s: %+v", operationId, id, err)
+		}
+
+		parameters := apimanagement.PolicyContract{
+			PolicyContractProperties: &apimanagement.PolicyContractProperties{
+				Format: apimanagement.PolicyContentFormatRawxml,
+				Value:  utils.String(policy),
+			},
+		}
+		if _, err := client.ApiManagement.ApiOperationPoliciesClient.CreateOrUpdate(ctx, id.ResourceGroup, id.ServiceName, apiId, operationId, parameters, ""); err != nil {
+			return fmt.Errorf("restoring policy for operation %q of %s: %+v", operationId, id, err)
+		}
+	}
+
+	return nil
+}
+
+func flattenApiManagementApiOperation(input apimanagement.OperationContract) map[string]interface{} {
+	output := map[string]interface{}{
+		"operation_id": utils.NormalizeNilableString(input.Name),
+		"display_name": "",
+		"method":       "",
+		"url_template": "",
+	}
+
+	if props := input.OperationContractProperties; props != nil {
+		output["display_name"] = utils.NormalizeNilableString(props.DisplayName)
+		output["method"] = utils.NormalizeNilableString(props.Method)
+		output["url_template"] = utils.NormalizeNilableString(props.URLTemplate)
+	}
+
+	return output
+}
+
 func expandApiManagementApiProtocols(input []interface{}) *[]apimanagement.Protocol {
 	if len(input) == 0 {
 		return nil
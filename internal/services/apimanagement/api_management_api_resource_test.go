@@ -154,6 +154,24 @@ func TestAccApiManagementApi_graphql(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApi_syntheticGraphql(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.syntheticGraphql(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("api_type").HasValue("synthetic_graphql"),
+				check.That(data.ResourceName).Key("service_url").HasValue(""),
+			),
+		},
+		// synthetic GraphQL APIs are imported with the `api_type` `graphql`
+		data.ImportStep("api_type"),
+	})
+}
+
 func TestAccApiManagementApi_soap(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -239,6 +257,8 @@ func TestAccApiManagementApi_importSwagger(t *testing.T) {
 			ImportStateVerifyIgnore: []string{
 				// not returned from the API
 				"import",
+				"import_content_hash",
+				"operation",
 			},
 		},
 	})
@@ -262,6 +282,8 @@ func TestAccApiManagementApi_importWsdl(t *testing.T) {
 			ImportStateVerifyIgnore: []string{
 				// not returned from the API
 				"import",
+				"import_content_hash",
+				"operation",
 			},
 		},
 	})
@@ -285,6 +307,8 @@ func TestAccApiManagementApi_importUpdate(t *testing.T) {
 			ImportStateVerifyIgnore: []string{
 				// not returned from the API
 				"import",
+				"import_content_hash",
+				"operation",
 			},
 		},
 		{
@@ -300,11 +324,48 @@ func TestAccApiManagementApi_importUpdate(t *testing.T) {
 			ImportStateVerifyIgnore: []string{
 				// not returned from the API
 				"import",
+				"import_content_hash",
+				"operation",
 			},
 		},
 	})
 }
 
+func TestAccApiManagementApi_importOpenApiUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
+	r := ApiManagementApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.importOpenApi(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("import_content_hash").Exists(),
+				check.That(data.ResourceName).Key("operation.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("import", "import_content_hash", "operation"),
+		{
+			// the policy of the `listUsers` operation must survive the re-import of the specification
+			Config: r.importOpenApi(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operation.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("import", "import_content_hash", "operation"),
+		{
+			// updating another property must not re-import the specification
+			Config: r.importOpenApiDescription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operation.#").HasValue("3"),
+			),
+		},
+		data.ImportStep("import", "import_content_hash", "operation"),
+	})
+}
+
 func TestAccApiManagementApi_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_api_management_api", "test")
 	r := ApiManagementApiResource{}
@@ -474,6 +535,23 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data, SkuNameConsumption), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) syntheticGraphql(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  api_type            = "synthetic_graphql"
+  display_name        = "api1"
+  path                = "api1"
+  protocols           = ["https"]
+  revision            = "1"
+}
+`, r.template(data, SkuNameConsumption), data.RandomInteger)
+}
+
 func (r ApiManagementApiResource) soap(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -580,6 +658,94 @@ resource "azurerm_api_management_api" "test" {
 `, r.template(data, SkuNameConsumption), data.RandomInteger)
 }
 
+func (r ApiManagementApiResource) importOpenApi(data acceptance.TestData, deleteUser bool) string {
+	return r.importOpenApiTemplate(data, deleteUser, "")
+}
+
+func (r ApiManagementApiResource) importOpenApiDescription(data acceptance.TestData) string {
+	return r.importOpenApiTemplate(data, true, "Manages the users")
+}
+
+func (r ApiManagementApiResource) importOpenApiTemplate(data acceptance.TestData, deleteUser bool, description string) string {
+	deleteUserOperation := ""
+	if deleteUser {
+		deleteUserOperation = `
+    delete:
+      operationId: deleteUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "204":
+          description: Deleted`
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  display_name        = "users"
+  path                = "users"
+  protocols           = ["https"]
+  revision            = "1"
+  description         = "%s"
+
+  import {
+    content_format = "openapi"
+    content_value  = <<SPEC
+openapi: 3.0.1
+info:
+  title: users
+  version: "1.0"
+servers:
+  - url: https://users.example.com
+paths:
+  /users:
+    get:
+      operationId: listUsers
+      responses:
+        "200":
+          description: OK
+  /users/{id}:
+    get:
+      operationId: getUser
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: OK%s
+SPEC
+  }
+}
+
+resource "azurerm_api_management_api_operation_policy" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  operation_id        = "listusers"
+  xml_content         = <<XML
+<policies>
+  <inbound>
+    <set-header name="X-Operation" exists-action="override">
+      <value>listUsers</value>
+    </set-header>
+  </inbound>
+</policies>
+XML
+}
+`, r.template(data, SkuNameConsumption), data.RandomInteger, description, deleteUserOperation)
+}
+
 func (r ApiManagementApiResource) importWsdl(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
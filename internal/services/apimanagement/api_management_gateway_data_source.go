@@ -59,6 +59,11 @@ func dataSourceApiManagementGateway() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"configuration_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -99,5 +104,7 @@ func dataSourceApiManagementGatewayRead(d *pluginsdk.ResourceData, meta interfac
 		d.Set("location_data", flattenApiManagementGatewayLocationData(properties.LocationData))
 	}
 
+	d.Set("configuration_endpoint", apiManagementGatewayConfigurationEndpoint(meta.(*clients.Client).Account.Environment, *apimId))
+
 	return nil
 }
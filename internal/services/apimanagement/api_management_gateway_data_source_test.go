@@ -20,6 +20,7 @@ func TestAccDataSourceApiManagementGateway_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("description").HasValue(""),
 				check.That(data.ResourceName).Key("location_data.0.name").HasValue("test"),
+				check.That(data.ResourceName).Key("configuration_endpoint").Exists(),
 			),
 		},
 	})
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
@@ -75,6 +76,11 @@ func resourceApiManagementGateway() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"configuration_endpoint": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -156,6 +162,8 @@ func resourceApiManagementGatewayRead(d *pluginsdk.ResourceData, meta interface{
 		d.Set("location_data", flattenApiManagementGatewayLocationData(properties.LocationData))
 	}
 
+	d.Set("configuration_endpoint", apiManagementGatewayConfigurationEndpoint(meta.(*clients.Client).Account.Environment, apimId))
+
 	return nil
 }
 
@@ -181,6 +189,22 @@ func resourceApiManagementGatewayDelete(d *pluginsdk.ResourceData, meta interfac
 	return nil
 }
 
+// apiManagementGatewayConfigurationEndpoint returns the endpoint a self-hosted gateway retrieves its configuration
+// from, which is the configuration API of the API Management Service - for example `example.configuration.azure-api.net`.
+// This is derived from the API Management domain suffix of the environment, and is empty when it isn't available
+func apiManagementGatewayConfigurationEndpoint(environment environments.Environment, id parse.ApiManagementId) string {
+	if environment.ApiManagement == nil {
+		return ""
+	}
+
+	domainSuffix, ok := environment.ApiManagement.DomainSuffix()
+	if !ok || domainSuffix == nil || *domainSuffix == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.configuration.%s", id.ServiceName, *domainSuffix)
+}
+
 func expandApiManagementGatewayLocationData(input []interface{}) *apimanagement.ResourceLocationDataContract {
 	if len(input) == 0 {
 		return nil
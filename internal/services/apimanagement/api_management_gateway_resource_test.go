@@ -26,6 +26,7 @@ func TestAccApiManagementGateway_basic(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("description").HasValue(""),
 				check.That(data.ResourceName).Key("location_data.0.name").HasValue("test"),
+				check.That(data.ResourceName).Key("configuration_endpoint").Exists(),
 			),
 		},
 		data.ImportStep(),
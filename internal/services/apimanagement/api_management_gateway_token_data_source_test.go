@@ -0,0 +1,53 @@
+package apimanagement_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiManagementGatewayTokenDataSource struct{}
+
+func TestAccDataSourceApiManagementGatewayToken_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_gateway_token", "test")
+	r := ApiManagementGatewayTokenDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "primary"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceApiManagementGatewayToken_secondary(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_gateway_token", "test")
+	r := ApiManagementGatewayTokenDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "secondary"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("key_type").HasValue("secondary"),
+				check.That(data.ResourceName).Key("value").Exists(),
+			),
+		},
+	})
+}
+
+func (ApiManagementGatewayTokenDataSource) basic(data acceptance.TestData, keyType string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_api_management_gateway_token" "test" {
+  gateway_id = azurerm_api_management_gateway.test.id
+  key_type   = "%s"
+  expiry     = "%s"
+}
+`, ApiManagementGatewayResource{}.basic(data), keyType, time.Now().UTC().Add(24*time.Hour).Format(time.RFC3339))
+}
@@ -0,0 +1,274 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/graphqlapiresolverpolicy"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverModel struct {
+	Name        string `tfschema:"name"`
+	ApiId       string `tfschema:"api_id"`
+	DisplayName string `tfschema:"display_name"`
+	Path        string `tfschema:"path"`
+	Description string `tfschema:"description"`
+	XmlContent  string `tfschema:"xml_content"`
+}
+
+type ApiManagementGraphQLApiResolverResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementGraphQLApiResolverResource{}
+
+func (r ApiManagementGraphQLApiResolverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: graphqlapiresolver.ValidateApiID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*/[_A-Za-z][_0-9A-Za-z]*$`),
+				"`path` must be in the format `{Type}/{Field}`, for example `Query/users`",
+			),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"xml_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     schemaz.ValidateApiManagementPolicyXml,
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) ModelObject() interface{} {
+	return &ApiManagementGraphQLApiResolverModel{}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) ResourceType() string {
+	return "azurerm_api_management_graphql_api_resolver"
+}
+
+func (r ApiManagementGraphQLApiResolverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return graphqlapiresolver.ValidateResolverID
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+			policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			apiId, err := graphqlapiresolver.ParseApiID(model.ApiId)
+			if err != nil {
+				return err
+			}
+
+			id := graphqlapiresolver.NewResolverID(apiId.SubscriptionId, apiId.ResourceGroupName, apiId.ServiceName, apiId.ApiId, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementGraphQLApiResolver(model), graphqlapiresolver.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if model.XmlContent != "" {
+				policyId := graphqlapiresolverpolicy.NewResolverID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+				if _, err := policyClient.CreateOrUpdate(ctx, policyId, expandApiManagementGraphQLApiResolverPolicy(model.XmlContent), graphqlapiresolverpolicy.DefaultCreateOrUpdateOperationOptions()); err != nil {
+					return fmt.Errorf("creating the policy for %s: %+v", id, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+			policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementGraphQLApiResolverModel{
+				Name:  id.ResolverId,
+				ApiId: graphqlapiresolver.NewApiID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId).ID(),
+			}
+
+			if resp.Model != nil && resp.Model.Properties != nil {
+				props := resp.Model.Properties
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Path = utils.NormalizeNilableString(props.Path)
+				model.Description = utils.NormalizeNilableString(props.Description)
+			}
+
+			// policy expressions are returned escaped unless the policy is requested as `rawxml`
+			format := graphqlapiresolverpolicy.PolicyExportFormatRawxml
+			policyId := graphqlapiresolverpolicy.NewResolverID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+			policy, err := policyClient.Get(ctx, policyId, graphqlapiresolverpolicy.GetOperationOptions{Format: &format})
+			if err != nil {
+				if !response.WasNotFound(policy.HttpResponse) {
+					return fmt.Errorf("retrieving the policy for %s: %+v", id, err)
+				}
+			}
+			if policy.Model != nil && policy.Model.Properties != nil {
+				model.XmlContent = policy.Model.Properties.Value
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+			policyClient := metadata.Client.ApiManagement.GraphQLApiResolverPolicyClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementGraphQLApiResolverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("display_name", "path", "description") {
+				if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementGraphQLApiResolver(model), graphqlapiresolver.CreateOrUpdateOperationOptions{IfMatch: utils.String("*")}); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			if metadata.ResourceData.HasChange("xml_content") {
+				policyId := graphqlapiresolverpolicy.NewResolverID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName, id.ApiId, id.ResolverId)
+				if model.XmlContent == "" {
+					if _, err := policyClient.Delete(ctx, policyId, graphqlapiresolverpolicy.DeleteOperationOptions{IfMatch: utils.String("*")}); err != nil {
+						return fmt.Errorf("removing the policy for %s: %+v", *id, err)
+					}
+				} else {
+					if _, err := policyClient.CreateOrUpdate(ctx, policyId, expandApiManagementGraphQLApiResolverPolicy(model.XmlContent), graphqlapiresolverpolicy.CreateOrUpdateOperationOptions{IfMatch: utils.String("*")}); err != nil {
+						return fmt.Errorf("updating the policy for %s: %+v", *id, err)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementGraphQLApiResolverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.GraphQLApiResolverClient
+
+			id, err := graphqlapiresolver.ParseResolverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, graphqlapiresolver.DeleteOperationOptions{IfMatch: utils.String("*")}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementGraphQLApiResolver(input ApiManagementGraphQLApiResolverModel) graphqlapiresolver.ResolverContract {
+	props := graphqlapiresolver.ResolverEntityBaseContract{
+		DisplayName: utils.String(input.DisplayName),
+		Path:        utils.String(input.Path),
+	}
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+
+	return graphqlapiresolver.ResolverContract{
+		Properties: &props,
+	}
+}
+
+func expandApiManagementGraphQLApiResolverPolicy(input string) graphqlapiresolverpolicy.PolicyContract {
+	format := graphqlapiresolverpolicy.PolicyContentFormat(schemaz.DetectApiManagementPolicyFormat(input))
+	return graphqlapiresolverpolicy.PolicyContract{
+		Properties: &graphqlapiresolverpolicy.PolicyContractProperties{
+			Format: &format,
+			Value:  input,
+		},
+	}
+}
@@ -0,0 +1,177 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementGraphQLApiResolverResource struct{}
+
+func TestAccApiManagementGraphQLApiResolver_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolver_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementGraphQLApiResolver_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_graphql_api_resolver", "test")
+	r := ApiManagementGraphQLApiResolverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("xml_content").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementGraphQLApiResolverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := graphqlapiresolver.ParseResolverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.GraphQLApiResolverClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementGraphQLApiResolverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "test" {
+  name         = "acctestresolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "Users"
+  path         = "Query/users"
+
+  depends_on = [azurerm_api_management_api_schema.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementGraphQLApiResolverResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "test" {
+  name         = "acctestresolver-%d"
+  api_id       = azurerm_api_management_api.test.id
+  display_name = "All Users"
+  path         = "Query/users"
+  description  = "Resolves the users from the users service"
+  xml_content  = <<XML
+<http-data-source>
+  <http-request>
+    <set-method>GET</set-method>
+    <set-url>https://users.example.com/api/users</set-url>
+  </http-request>
+</http-data-source>
+XML
+
+  depends_on = [azurerm_api_management_api_schema.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ApiManagementGraphQLApiResolverResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_graphql_api_resolver" "import" {
+  name         = azurerm_api_management_graphql_api_resolver.test.name
+  api_id       = azurerm_api_management_graphql_api_resolver.test.api_id
+  display_name = azurerm_api_management_graphql_api_resolver.test.display_name
+  path         = azurerm_api_management_graphql_api_resolver.test.path
+}
+`, r.basic(data))
+}
+
+func (ApiManagementGraphQLApiResolverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api" "test" {
+  name                = "acctestapi-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  api_management_name = azurerm_api_management.test.name
+  api_type            = "synthetic_graphql"
+  display_name        = "users"
+  path                = "users"
+  protocols           = ["https"]
+  revision            = "1"
+}
+
+resource "azurerm_api_management_api_schema" "test" {
+  api_name            = azurerm_api_management_api.test.name
+  api_management_name = azurerm_api_management.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  schema_id           = "graphql"
+  content_type        = "application/vnd.ms-azure-apim.graphql.schema"
+  value               = <<SCHEMA
+type User {
+  id: ID!
+  name: String
+}
+
+type Query {
+  users: [User]
+}
+SCHEMA
+}
+`, ApiManagementApiResource{}.template(data, SkuNameConsumption), data.RandomInteger)
+}
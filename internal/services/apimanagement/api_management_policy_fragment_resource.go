@@ -0,0 +1,229 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policyfragment"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementPolicyFragmentModel struct {
+	Name            string `tfschema:"name"`
+	ApiManagementId string `tfschema:"api_management_id"`
+	Value           string `tfschema:"value"`
+	Format          string `tfschema:"format"`
+	Description     string `tfschema:"description"`
+}
+
+type ApiManagementPolicyFragmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementPolicyFragmentResource{}
+
+func (r ApiManagementPolicyFragmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: policyfragment.ValidateServiceID,
+		},
+
+		"value": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     schemaz.ValidateApiManagementPolicyXml,
+			DiffSuppressFunc: XmlWithDotNetInterpolationsDiffSuppress,
+		},
+
+		"format": schemaz.SchemaApiManagementPolicyFormat(),
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementPolicyFragmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementPolicyFragmentResource) ModelObject() interface{} {
+	return &ApiManagementPolicyFragmentModel{}
+}
+
+func (r ApiManagementPolicyFragmentResource) ResourceType() string {
+	return "azurerm_api_management_policy_fragment"
+}
+
+func (r ApiManagementPolicyFragmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return policyfragment.ValidatePolicyFragmentID
+}
+
+func (r ApiManagementPolicyFragmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			var model ApiManagementPolicyFragmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			apiManagementId, err := policyfragment.ParseServiceID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := policyfragment.NewPolicyFragmentID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroupName, apiManagementId.ServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id, policyfragment.DefaultGetOperationOptions())
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if model.Format == "" {
+				model.Format = schemaz.DetectApiManagementPolicyFormat(model.Value)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApiManagementPolicyFragment(model), policyfragment.DefaultCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return metadata.ResourceData.Set("format", model.Format)
+		},
+	}
+}
+
+func (r ApiManagementPolicyFragmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the value is returned in the requested format, so retrieve it in the format it was sent in
+			format := policyfragment.PolicyFragmentContentFormatXml
+			if v, ok := metadata.ResourceData.GetOk("format"); ok {
+				format = policyfragment.PolicyFragmentContentFormat(v.(string))
+			}
+
+			resp, err := client.Get(ctx, *id, policyfragment.GetOperationOptions{Format: &format})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementPolicyFragmentModel{
+				Name:            id.PolicyFragmentName,
+				ApiManagementId: policyfragment.NewServiceID(id.SubscriptionId, id.ResourceGroupName, id.ServiceName).ID(),
+				Format:          string(format),
+			}
+
+			if resp.Model != nil && resp.Model.Properties != nil {
+				props := resp.Model.Properties
+				model.Value = props.Value
+				model.Description = utils.NormalizeNilableString(props.Description)
+				if props.Format != nil {
+					model.Format = string(*props.Format)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementPolicyFragmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementPolicyFragmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the format is Computed, so when it's not configured it has to be re-detected from the new value
+			if metadata.ResourceData.GetRawConfig().GetAttr("format").IsNull() {
+				model.Format = schemaz.DetectApiManagementPolicyFormat(model.Value)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApiManagementPolicyFragment(model), policyfragment.CreateOrUpdateOperationOptions{IfMatch: utils.String("*")}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return metadata.ResourceData.Set("format", model.Format)
+		},
+	}
+}
+
+func (r ApiManagementPolicyFragmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.PolicyFragmentClient
+
+			id, err := policyfragment.ParsePolicyFragmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id, policyfragment.DeleteOperationOptions{IfMatch: utils.String("*")}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementPolicyFragment(input ApiManagementPolicyFragmentModel) policyfragment.PolicyFragmentContract {
+	format := policyfragment.PolicyFragmentContentFormat(input.Format)
+	props := policyfragment.PolicyFragmentContractProperties{
+		Format: &format,
+		Value:  input.Value,
+	}
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+
+	return policyfragment.PolicyFragmentContract{
+		Properties: &props,
+	}
+}
@@ -0,0 +1,299 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceApiModel struct {
+	Name                 string   `tfschema:"name"`
+	WorkspaceId          string   `tfschema:"api_management_workspace_id"`
+	DisplayName          string   `tfschema:"display_name"`
+	Path                 string   `tfschema:"path"`
+	Protocols            []string `tfschema:"protocols"`
+	ApiType              string   `tfschema:"api_type"`
+	Revision             string   `tfschema:"revision"`
+	ServiceUrl           string   `tfschema:"service_url"`
+	Description          string   `tfschema:"description"`
+	SubscriptionRequired bool     `tfschema:"subscription_required"`
+	IsCurrent            bool     `tfschema:"is_current"`
+}
+
+type ApiManagementWorkspaceApiResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceApiResource{}
+
+func (r ApiManagementWorkspaceApiResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementApiName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ApiManagementApiPath,
+		},
+
+		"protocols": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(workspace.PossibleValuesForProtocol(), false),
+			},
+		},
+
+		"api_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      string(workspace.ApiTypeHttp),
+			ValidateFunc: validation.StringInSlice(workspace.PossibleValuesForApiType(), false),
+		},
+
+		"revision": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "1",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"service_url": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"is_current": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceApiModel{}
+}
+
+func (r ApiManagementWorkspaceApiResource) ResourceType() string {
+	return "azurerm_api_management_workspace_api"
+}
+
+func (r ApiManagementWorkspaceApiResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceApiID
+}
+
+func (r ApiManagementWorkspaceApiResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceApiID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApiManagementWorkspaceApi(model), workspace.DefaultWorkspaceApiCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := parse.WorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementWorkspaceApiModel{
+				Name:        id.ApiName,
+				WorkspaceId: parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName).ID(),
+				ApiType:     string(workspace.ApiTypeHttp),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.DisplayName = pointer.From(props.DisplayName)
+					model.Path = props.Path
+					model.Revision = pointer.From(props.ApiRevision)
+					model.ServiceUrl = pointer.From(props.ServiceUrl)
+					model.Description = pointer.From(props.Description)
+					model.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+					model.IsCurrent = pointer.From(props.IsCurrent)
+
+					if props.Type != nil {
+						model.ApiType = string(*props.Type)
+					}
+
+					protocols := make([]string, 0)
+					if props.Protocols != nil {
+						for _, v := range *props.Protocols {
+							protocols = append(protocols, string(v))
+						}
+					}
+					model.Protocols = protocols
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := parse.WorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceApiModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the API requires the `If-Match` header to be specified when updating an existing API
+			options := workspace.WorkspaceApiCreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApiManagementWorkspaceApi(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceApiResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceApiClient
+
+			id, err := parse.WorkspaceApiID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspace.WorkspaceApiDeleteOperationOptions{
+				DeleteRevisions: pointer.To(true),
+				IfMatch:         pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceApi(input ApiManagementWorkspaceApiModel) workspace.ApiContract {
+	protocols := make([]workspace.Protocol, 0)
+	for _, v := range input.Protocols {
+		protocols = append(protocols, workspace.Protocol(v))
+	}
+
+	props := workspace.ApiContractProperties{
+		ApiRevision:          pointer.To(input.Revision),
+		DisplayName:          pointer.To(input.DisplayName),
+		Path:                 input.Path,
+		Protocols:            &protocols,
+		SubscriptionRequired: pointer.To(input.SubscriptionRequired),
+		Type:                 pointer.To(workspace.ApiType(input.ApiType)),
+	}
+	if input.ServiceUrl != "" {
+		props.ServiceUrl = pointer.To(input.ServiceUrl)
+	}
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	return workspace.ApiContract{
+		Properties: &props,
+	}
+}
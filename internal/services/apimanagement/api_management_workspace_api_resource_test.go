@@ -0,0 +1,135 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceApiResource struct{}
+
+func TestAccApiManagementWorkspaceApi_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("is_current").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceApi_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_api", "test")
+	r := ApiManagementWorkspaceApiResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceApiResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceApiID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceApiClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceApiResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "orders"
+  path                        = "orders"
+  protocols                   = ["https"]
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceApiResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "test" {
+  name                        = "acctestapi-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "orders v2"
+  path                        = "orders/v2"
+  protocols                   = ["http", "https"]
+  service_url                 = "https://orders.example.com"
+  description                 = "The Orders API owned by Team A"
+  subscription_required       = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceApiResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_api" "import" {
+  name                        = azurerm_api_management_workspace_api.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_api.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_api.test.display_name
+  path                        = azurerm_api_management_workspace_api.test.path
+  protocols                   = azurerm_api_management_workspace_api.test.protocols
+}
+`, r.basic(data))
+}
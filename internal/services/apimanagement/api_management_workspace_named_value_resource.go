@@ -0,0 +1,239 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceNamedValueModel struct {
+	Name        string   `tfschema:"name"`
+	WorkspaceId string   `tfschema:"api_management_workspace_id"`
+	DisplayName string   `tfschema:"display_name"`
+	Value       string   `tfschema:"value"`
+	Secret      bool     `tfschema:"secret"`
+	Tags        []string `tfschema:"tags"`
+}
+
+type ApiManagementWorkspaceNamedValueResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceNamedValueResource{}
+
+func (r ApiManagementWorkspaceNamedValueResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"secret": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceNamedValueModel{}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) ResourceType() string {
+	return "azurerm_api_management_workspace_named_value"
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceNamedValueID
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceNamedValueClient
+
+			var model ApiManagementWorkspaceNamedValueModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceNamedValueID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, expandApiManagementWorkspaceNamedValue(model), workspace.DefaultWorkspaceNamedValueCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceNamedValueClient
+
+			id, err := parse.WorkspaceNamedValueID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementWorkspaceNamedValueModel{
+				Name:        id.NamedValueName,
+				WorkspaceId: parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.DisplayName = props.DisplayName
+					model.Secret = pointer.From(props.Secret)
+					model.Tags = pointer.From(props.Tags)
+					model.Value = pointer.From(props.Value)
+				}
+			}
+
+			// the value of a secret isn't returned by the API, so it's retained from the configuration
+			if model.Secret {
+				model.Value = metadata.ResourceData.Get("value").(string)
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceNamedValueClient
+
+			id, err := parse.WorkspaceNamedValueID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceNamedValueModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the API requires the `If-Match` header to be specified when updating an existing Named Value
+			options := workspace.WorkspaceNamedValueCreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, expandApiManagementWorkspaceNamedValue(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceNamedValueClient
+
+			id, err := parse.WorkspaceNamedValueID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspace.WorkspaceNamedValueDeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceNamedValue(input ApiManagementWorkspaceNamedValueModel) workspace.NamedValueContract {
+	props := workspace.NamedValueContractProperties{
+		DisplayName: input.DisplayName,
+		Secret:      pointer.To(input.Secret),
+		Value:       pointer.To(input.Value),
+	}
+	if len(input.Tags) > 0 {
+		props.Tags = &input.Tags
+	}
+
+	return workspace.NamedValueContract{
+		Properties: &props,
+	}
+}
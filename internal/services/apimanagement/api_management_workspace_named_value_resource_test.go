@@ -0,0 +1,130 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceNamedValueResource struct{}
+
+func TestAccApiManagementWorkspaceNamedValue_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_named_value", "test")
+	r := ApiManagementWorkspaceNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceNamedValue_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_named_value", "test")
+	r := ApiManagementWorkspaceNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceNamedValue_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_named_value", "test")
+	r := ApiManagementWorkspaceNamedValueResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.secret(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("value"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceNamedValueResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceNamedValueID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceNamedValueClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_named_value" "test" {
+  name                        = "acctestnv-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "BackendHost"
+  value                       = "orders.example.com"
+  tags                        = ["team-a"]
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) secret(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_named_value" "test" {
+  name                        = "acctestnv-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "BackendKey"
+  value                       = "s3cr3t-v4lu3"
+  secret                      = true
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceNamedValueResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_named_value" "import" {
+  name                        = azurerm_api_management_workspace_named_value.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_named_value.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_named_value.test.display_name
+  value                       = azurerm_api_management_workspace_named_value.test.value
+}
+`, r.basic(data))
+}
@@ -0,0 +1,289 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceProductModel struct {
+	Name                 string `tfschema:"name"`
+	WorkspaceId          string `tfschema:"api_management_workspace_id"`
+	DisplayName          string `tfschema:"display_name"`
+	Published            bool   `tfschema:"published"`
+	SubscriptionRequired bool   `tfschema:"subscription_required"`
+	ApprovalRequired     bool   `tfschema:"approval_required"`
+	SubscriptionsLimit   int64  `tfschema:"subscriptions_limit"`
+	Description          string `tfschema:"description"`
+	Terms                string `tfschema:"terms"`
+}
+
+type ApiManagementWorkspaceProductResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceProductResource{}
+
+func (r ApiManagementWorkspaceProductResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.WorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"published": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+
+		"subscription_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"approval_required": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"subscriptions_limit": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"terms": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceProductModel{}
+}
+
+func (r ApiManagementWorkspaceProductResource) ResourceType() string {
+	return "azurerm_api_management_workspace_product"
+}
+
+func (r ApiManagementWorkspaceProductResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceProductID
+}
+
+func (r ApiManagementWorkspaceProductResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// mirrors the validation performed by the API, which otherwise only surfaces once applied
+			if !model.SubscriptionRequired && (model.ApprovalRequired || model.SubscriptionsLimit > 0) {
+				return fmt.Errorf("`approval_required` and `subscriptions_limit` can only be set when `subscription_required` is `true`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := parse.WorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceProductID(workspaceId.SubscriptionId, workspaceId.ResourceGroup, workspaceId.ServiceName, workspaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementWorkspaceProduct(model), workspace.DefaultWorkspaceProductCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := parse.WorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementWorkspaceProductModel{
+				Name:        id.ProductName,
+				WorkspaceId: parse.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName).ID(),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.DisplayName = props.DisplayName
+					model.Published = pointer.From(props.State) == workspace.ProductStatePublished
+					model.SubscriptionRequired = pointer.From(props.SubscriptionRequired)
+					model.ApprovalRequired = pointer.From(props.ApprovalRequired)
+					model.SubscriptionsLimit = pointer.From(props.SubscriptionsLimit)
+					model.Description = pointer.From(props.Description)
+					model.Terms = pointer.From(props.Terms)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := parse.WorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceProductModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the API requires the `If-Match` header to be specified when updating an existing Product
+			options := workspace.WorkspaceProductCreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementWorkspaceProduct(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceProductResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceProductClient
+
+			id, err := parse.WorkspaceProductID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspace.WorkspaceProductDeleteOperationOptions{
+				DeleteSubscriptions: pointer.To(true),
+				IfMatch:             pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspaceProduct(input ApiManagementWorkspaceProductModel) workspace.ProductContract {
+	state := workspace.ProductStateNotPublished
+	if input.Published {
+		state = workspace.ProductStatePublished
+	}
+
+	props := workspace.ProductContractProperties{
+		DisplayName:          input.DisplayName,
+		State:                pointer.To(state),
+		SubscriptionRequired: pointer.To(input.SubscriptionRequired),
+	}
+
+	// these can only be specified when a subscription is required
+	if input.SubscriptionRequired {
+		props.ApprovalRequired = pointer.To(input.ApprovalRequired)
+		if input.SubscriptionsLimit > 0 {
+			props.SubscriptionsLimit = pointer.To(input.SubscriptionsLimit)
+		}
+	}
+
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+	if input.Terms != "" {
+		props.Terms = pointer.To(input.Terms)
+	}
+
+	return workspace.ProductContract{
+		Properties: &props,
+	}
+}
@@ -0,0 +1,133 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ApiManagementWorkspaceProductResource struct{}
+
+func TestAccApiManagementWorkspaceProduct_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccApiManagementWorkspaceProduct_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_workspace_product", "test")
+	r := ApiManagementWorkspaceProductResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ApiManagementWorkspaceProductResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.WorkspaceProductID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.WorkspaceProductClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ApiManagementWorkspaceProductResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Starter"
+  published                   = false
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceProductResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "test" {
+  name                        = "acctestproduct-%d"
+  api_management_workspace_id = azurerm_api_management_workspace.test.id
+  display_name                = "Unlimited"
+  published                   = true
+  subscription_required       = true
+  approval_required           = true
+  subscriptions_limit         = 2
+  description                 = "Unlimited access to the Team A APIs"
+  terms                       = "Use responsibly"
+}
+`, ApiManagementWorkspaceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ApiManagementWorkspaceProductResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_workspace_product" "import" {
+  name                        = azurerm_api_management_workspace_product.test.name
+  api_management_workspace_id = azurerm_api_management_workspace_product.test.api_management_workspace_id
+  display_name                = azurerm_api_management_workspace_product.test.display_name
+  published                   = azurerm_api_management_workspace_product.test.published
+}
+`, r.basic(data))
+}
@@ -0,0 +1,212 @@
+package apimanagement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ApiManagementWorkspaceModel struct {
+	Name            string `tfschema:"name"`
+	ApiManagementId string `tfschema:"api_management_id"`
+	DisplayName     string `tfschema:"display_name"`
+	Description     string `tfschema:"description"`
+}
+
+type ApiManagementWorkspaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = ApiManagementWorkspaceResource{}
+
+func (r ApiManagementWorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementChildName,
+		},
+
+		"api_management_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ApiManagementID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ApiManagementWorkspaceResource) ModelObject() interface{} {
+	return &ApiManagementWorkspaceModel{}
+}
+
+func (r ApiManagementWorkspaceResource) ResourceType() string {
+	return "azurerm_api_management_workspace"
+}
+
+func (r ApiManagementWorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WorkspaceID
+}
+
+func (r ApiManagementWorkspaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceClient
+
+			var model ApiManagementWorkspaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			apiManagementId, err := parse.ApiManagementID(model.ApiManagementId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewWorkspaceID(apiManagementId.SubscriptionId, apiManagementId.ResourceGroup, apiManagementId.ServiceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandApiManagementWorkspace(model), workspace.DefaultWorkspaceCreateOrUpdateOperationOptions()); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceClient
+
+			id, err := parse.WorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ApiManagementWorkspaceModel{
+				Name:            id.Name,
+				ApiManagementId: parse.NewApiManagementID(id.SubscriptionId, id.ResourceGroup, id.ServiceName).ID(),
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					model.DisplayName = props.DisplayName
+					model.Description = pointer.From(props.Description)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceClient
+
+			id, err := parse.WorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ApiManagementWorkspaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the API requires the `If-Match` header to be specified when updating an existing Workspace
+			options := workspace.WorkspaceCreateOrUpdateOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.CreateOrUpdate(ctx, *id, expandApiManagementWorkspace(model), options); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ApiManagementWorkspaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ApiManagement.WorkspaceClient
+
+			id, err := parse.WorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			options := workspace.WorkspaceDeleteOperationOptions{
+				IfMatch: pointer.To("*"),
+			}
+			if _, err := client.Delete(ctx, *id, options); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandApiManagementWorkspace(input ApiManagementWorkspaceModel) workspace.WorkspaceContract {
+	props := workspace.WorkspaceContractProperties{
+		DisplayName: input.DisplayName,
+	}
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	return workspace.WorkspaceContract{
+		Properties: &props,
+	}
+}
@@ -3,7 +3,11 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2021-08-01/apimanagement" // nolint: staticcheck
 	pandoraAPIMGlobalSchema "github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2021-08-01/schema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/graphqlapiresolver"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/graphqlapiresolverpolicy"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/apimanagement/2022-08-01/policyfragment"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/sdk/2023-03-01-preview/workspace"
 )
 
 type Client struct {
@@ -29,6 +33,8 @@ type Client struct {
 	GatewayClient                      *apimanagement.GatewayClient
 	GatewayHostNameConfigurationClient *apimanagement.GatewayHostnameConfigurationClient
 	GlobalSchemaClient                 *pandoraAPIMGlobalSchema.SchemaClient
+	GraphQLApiResolverClient           *graphqlapiresolver.GraphQLApiResolverClient
+	GraphQLApiResolverPolicyClient     *graphqlapiresolverpolicy.GraphQLApiResolverPolicyClient
 	GroupClient                        *apimanagement.GroupClient
 	GroupUsersClient                   *apimanagement.GroupUserClient
 	IdentityProviderClient             *apimanagement.IdentityProviderClient
@@ -38,6 +44,7 @@ type Client struct {
 	NotificationRecipientUserClient    *apimanagement.NotificationRecipientUserClient
 	OpenIdConnectClient                *apimanagement.OpenIDConnectProviderClient
 	PolicyClient                       *apimanagement.PolicyClient
+	PolicyFragmentClient               *policyfragment.PolicyFragmentClient
 	ProductApisClient                  *apimanagement.ProductAPIClient
 	ProductGroupsClient                *apimanagement.ProductGroupClient
 	ProductPoliciesClient              *apimanagement.ProductPolicyClient
@@ -49,9 +56,13 @@ type Client struct {
 	TagClient                          *apimanagement.TagClient
 	TenantAccessClient                 *apimanagement.TenantAccessClient
 	UsersClient                        *apimanagement.UserClient
+	WorkspaceApiClient                 *workspace.WorkspaceApiClient
+	WorkspaceClient                    *workspace.WorkspaceClient
+	WorkspaceNamedValueClient          *workspace.WorkspaceNamedValueClient
+	WorkspaceProductClient             *workspace.WorkspaceProductClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	apiClient := apimanagement.NewAPIClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&apiClient.Client, o.ResourceManagerAuthorizer)
 
@@ -118,6 +129,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	globalSchemaClient := pandoraAPIMGlobalSchema.NewSchemaClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&globalSchemaClient.Client, o.ResourceManagerAuthorizer)
 
+	graphQLApiResolverClient := graphqlapiresolver.NewGraphQLApiResolverClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverClient.Client, o.ResourceManagerAuthorizer)
+
+	graphQLApiResolverPolicyClient := graphqlapiresolverpolicy.NewGraphQLApiResolverPolicyClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&graphQLApiResolverPolicyClient.Client, o.ResourceManagerAuthorizer)
+
 	groupClient := apimanagement.NewGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupClient.Client, o.ResourceManagerAuthorizer)
 
@@ -145,6 +162,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	policyClient := apimanagement.NewPolicyClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&policyClient.Client, o.ResourceManagerAuthorizer)
 
+	policyFragmentClient := policyfragment.NewPolicyFragmentClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&policyFragmentClient.Client, o.ResourceManagerAuthorizer)
+
 	productsClient := apimanagement.NewProductClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&productsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -178,6 +198,30 @@ func NewClient(o *common.ClientOptions) *Client {
 	usersClient := apimanagement.NewUserClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&usersClient.Client, o.ResourceManagerAuthorizer)
 
+	workspaceApiClient, err := workspace.NewWorkspaceApiClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(workspaceApiClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceClient, err := workspace.NewWorkspaceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(workspaceClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceNamedValueClient, err := workspace.NewWorkspaceNamedValueClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(workspaceNamedValueClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceProductClient, err := workspace.NewWorkspaceProductClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(workspaceProductClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		ApiClient:                          &apiClient,
 		ApiDiagnosticClient:                &apiDiagnosticClient,
@@ -201,6 +245,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		GatewayClient:                      &gatewayClient,
 		GatewayHostNameConfigurationClient: &gatewayHostnameConfigurationClient,
 		GlobalSchemaClient:                 &globalSchemaClient,
+		GraphQLApiResolverClient:           &graphQLApiResolverClient,
+		GraphQLApiResolverPolicyClient:     &graphQLApiResolverPolicyClient,
 		GroupClient:                        &groupClient,
 		GroupUsersClient:                   &groupUsersClient,
 		IdentityProviderClient:             &identityProviderClient,
@@ -210,6 +256,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		NotificationRecipientUserClient:    &notificationRecipientUserClient,
 		OpenIdConnectClient:                &openIdConnectClient,
 		PolicyClient:                       &policyClient,
+		PolicyFragmentClient:               &policyFragmentClient,
 		ProductApisClient:                  &productApisClient,
 		ProductGroupsClient:                &productGroupsClient,
 		ProductPoliciesClient:              &productPoliciesClient,
@@ -221,5 +268,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		TagClient:                          &tagClient,
 		TenantAccessClient:                 &tenantAccessClient,
 		UsersClient:                        &usersClient,
-	}
+		WorkspaceApiClient:                 workspaceApiClient,
+		WorkspaceClient:                    workspaceClient,
+		WorkspaceNamedValueClient:          workspaceNamedValueClient,
+		WorkspaceProductClient:             workspaceProductClient,
+	}, nil
 }
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type WorkspaceNamedValueId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	WorkspaceName  string
+	NamedValueName string
+}
+
+func NewWorkspaceNamedValueID(subscriptionId, resourceGroup, serviceName, workspaceName, namedValueName string) WorkspaceNamedValueId {
+	return WorkspaceNamedValueId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		WorkspaceName:  workspaceName,
+		NamedValueName: namedValueName,
+	}
+}
+
+func (id WorkspaceNamedValueId) String() string {
+	segments := []string{
+		fmt.Sprintf("Named Value Name %q", id.NamedValueName),
+		fmt.Sprintf("Workspace Name %q", id.WorkspaceName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Workspace Named Value", segmentsStr)
+}
+
+func (id WorkspaceNamedValueId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/workspaces/%s/namedValues/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.WorkspaceName, id.NamedValueName)
+}
+
+// WorkspaceNamedValueID parses a WorkspaceNamedValue ID into an WorkspaceNamedValueId struct
+func WorkspaceNamedValueID(input string) (*WorkspaceNamedValueId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := WorkspaceNamedValueId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.NamedValueName, err = id.PopSegment("namedValues"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
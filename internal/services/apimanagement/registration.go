@@ -33,6 +33,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management_api_version_set":                 dataSourceApiManagementApiVersionSet(),
 		"azurerm_api_management_gateway":                         dataSourceApiManagementGateway(),
 		"azurerm_api_management_gateway_host_name_configuration": dataSourceApiManagementGatewayHostNameConfiguration(),
+		"azurerm_api_management_gateway_token":                   dataSourceApiManagementGatewayToken(),
 		"azurerm_api_management_group":                           dataSourceApiManagementGroup(),
 		"azurerm_api_management_product":                         dataSourceApiManagementProduct(),
 		"azurerm_api_management_user":                            dataSourceApiManagementUser(),
@@ -95,7 +96,13 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		ApiManagementGraphQLApiResolverResource{},
 		ApiManagementNotificationRecipientEmailResource{},
 		ApiManagementNotificationRecipientUserResource{},
+		ApiManagementPolicyFragmentResource{},
+		ApiManagementWorkspaceApiResource{},
+		ApiManagementWorkspaceNamedValueResource{},
+		ApiManagementWorkspaceProductResource{},
+		ApiManagementWorkspaceResource{},
 	}
 }
@@ -0,0 +1,141 @@
+package schemaz
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	PolicyFormatXml    = "xml"
+	PolicyFormatRawXml = "rawxml"
+)
+
+// SchemaApiManagementPolicyFormat returns the Schema for the format of a Policy document,
+// which when omitted is detected from the contents of the document
+func SchemaApiManagementPolicyFormat() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeString,
+		Optional: true,
+		Computed: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			PolicyFormatXml,
+			PolicyFormatRawXml,
+		}, false),
+	}
+}
+
+// ValidateApiManagementPolicyXml validates that the value is a Policy document, which is either
+// well-formed XML or XML containing unescaped policy expressions (e.g. `@(context.Request.Url)`)
+func ValidateApiManagementPolicyXml(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	value := strings.TrimSpace(v)
+	if value == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	// policy expressions aren't XML-escaped in `rawxml` documents, so they're removed prior to parsing the document
+	if err := parseApiManagementPolicyXml(removeApiManagementPolicyExpressions(value)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a well-formed XML document: %+v", k, err))
+	}
+
+	return
+}
+
+func parseApiManagementPolicyXml(input string) error {
+	decoder := xml.NewDecoder(strings.NewReader(input))
+	hasElement := false
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, ok := token.(xml.StartElement); ok {
+			hasElement = true
+		}
+	}
+
+	if !hasElement {
+		return fmt.Errorf("no root element was found")
+	}
+
+	return nil
+}
+
+// removeApiManagementPolicyExpressions replaces each policy expression - either a single statement `@(...)`
+// or a multi-statement `@{...}` expression - with a placeholder, skipping over any string literals within them
+func removeApiManagementPolicyExpressions(input string) string {
+	var output strings.Builder
+	for i := 0; i < len(input); i++ {
+		if input[i] != '@' || i+1 >= len(input) || (input[i+1] != '(' && input[i+1] != '{') {
+			output.WriteByte(input[i])
+			continue
+		}
+
+		end := findApiManagementPolicyExpressionEnd(input, i+1)
+		if end == -1 {
+			// the expression isn't terminated, so the remainder is left as-is to fail parsing
+			output.WriteString(input[i:])
+			break
+		}
+
+		output.WriteString("expression")
+		i = end
+	}
+
+	return output.String()
+}
+
+func findApiManagementPolicyExpressionEnd(input string, start int) int {
+	depth := 0
+	for i := start; i < len(input); i++ {
+		switch input[i] {
+		case '(', '{':
+			depth++
+		case ')', '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		case '"', '\'':
+			quote := input[i]
+			for i++; i < len(input) && input[i] != quote; i++ {
+				if input[i] == '\\' {
+					i++
+				}
+			}
+		}
+	}
+
+	return -1
+}
+
+// DetectApiManagementPolicyFormat returns `xml` when the Policy document can be parsed as XML and
+// `rawxml` otherwise, since policy expressions containing characters such as `<` or `&` are only
+// accepted by the API when they're not XML-escaped
+func DetectApiManagementPolicyFormat(input string) string {
+	decoder := xml.NewDecoder(strings.NewReader(input))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			return PolicyFormatXml
+		}
+		if err != nil {
+			return PolicyFormatRawXml
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package schemaz
+
+import "testing"
+
+func TestDetectApiManagementPolicyFormat(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    `<fragment><set-header name="X-Env" exists-action="override"><value>prod</value></set-header></fragment>`,
+			Expected: PolicyFormatXml,
+		},
+		{
+			Input:    `<fragment><set-variable name="x" value="@(context.Request.Headers.GetValueOrDefault("a", "b"))" /></fragment>`,
+			Expected: PolicyFormatRawXml,
+		},
+		{
+			Input:    `<fragment><choose><when condition="@(context.Response.StatusCode < 400)"><return-response /></when></choose></fragment>`,
+			Expected: PolicyFormatRawXml,
+		},
+		{
+			Input:    `<fragment><set-body>@(context.Variables["a"] && true)</set-body></fragment>`,
+			Expected: PolicyFormatRawXml,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := DetectApiManagementPolicyFormat(v.Input); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestValidateApiManagementPolicyXml(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "   ",
+			Valid: false,
+		},
+		{
+			Input: "fragment",
+			Valid: false,
+		},
+		{
+			Input: "<fragment></fragment>",
+			Valid: true,
+		},
+		{
+			Input: "\n<fragment>\n\t<base />\n</fragment>\n",
+			Valid: true,
+		},
+		{
+			Input: "<fragment><base></fragment>",
+			Valid: false,
+		},
+		{
+			Input: "<fragment>",
+			Valid: false,
+		},
+		{
+			Input: "<fragment></fragment><",
+			Valid: false,
+		},
+		{
+			Input: `<fragment><set-variable name="x" value="@(context.Request.Headers.GetValueOrDefault("a", "b"))" /></fragment>`,
+			Valid: true,
+		},
+		{
+			Input: `<fragment><choose><when condition="@(context.Response.StatusCode < 400)"><return-response /></when></choose></fragment>`,
+			Valid: true,
+		},
+		{
+			Input: `<fragment><set-body>@{ var body = "<a>)"; return body; }</set-body></fragment>`,
+			Valid: true,
+		},
+		{
+			Input: `<fragment><set-body>@(context.Variables["a"] && true)</set-body>`,
+			Valid: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		_, errors := ValidateApiManagementPolicyXml(v.Input, "value")
+		if valid := len(errors) == 0; valid != v.Valid {
+			t.Fatalf("expected %t but got %t", v.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package workspace
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type WorkspaceClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewWorkspaceClientWithBaseURI(api environments.Api) (*WorkspaceClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "workspace", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating WorkspaceClient: %+v", err)
+	}
+
+	return &WorkspaceClient{
+		Client: client,
+	}, nil
+}
+
+type WorkspaceApiClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewWorkspaceApiClientWithBaseURI(api environments.Api) (*WorkspaceApiClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "workspace", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating WorkspaceApiClient: %+v", err)
+	}
+
+	return &WorkspaceApiClient{
+		Client: client,
+	}, nil
+}
+
+type WorkspaceNamedValueClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewWorkspaceNamedValueClientWithBaseURI(api environments.Api) (*WorkspaceNamedValueClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "workspace", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating WorkspaceNamedValueClient: %+v", err)
+	}
+
+	return &WorkspaceNamedValueClient{
+		Client: client,
+	}, nil
+}
+
+type WorkspaceProductClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewWorkspaceProductClientWithBaseURI(api environments.Api) (*WorkspaceProductClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "workspace", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating WorkspaceProductClient: %+v", err)
+	}
+
+	return &WorkspaceProductClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,44 @@
+package workspace
+
+type ApiType string
+
+const (
+	ApiTypeGraphql   ApiType = "graphql"
+	ApiTypeHttp      ApiType = "http"
+	ApiTypeSoap      ApiType = "soap"
+	ApiTypeWebsocket ApiType = "websocket"
+)
+
+func PossibleValuesForApiType() []string {
+	return []string{
+		string(ApiTypeGraphql),
+		string(ApiTypeHttp),
+		string(ApiTypeSoap),
+		string(ApiTypeWebsocket),
+	}
+}
+
+type ProductState string
+
+const (
+	ProductStateNotPublished ProductState = "notPublished"
+	ProductStatePublished    ProductState = "published"
+)
+
+type Protocol string
+
+const (
+	ProtocolHttp  Protocol = "http"
+	ProtocolHttps Protocol = "https"
+	ProtocolWs    Protocol = "ws"
+	ProtocolWss   Protocol = "wss"
+)
+
+func PossibleValuesForProtocol() []string {
+	return []string{
+		string(ProtocolHttp),
+		string(ProtocolHttps),
+		string(ProtocolWs),
+		string(ProtocolWss),
+	}
+}
@@ -0,0 +1,69 @@
+package workspace
+
+type ApiContract struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ApiContractProperties `json:"properties,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ApiContractProperties struct {
+	ApiRevision            *string     `json:"apiRevision,omitempty"`
+	ApiRevisionDescription *string     `json:"apiRevisionDescription,omitempty"`
+	ApiVersion             *string     `json:"apiVersion,omitempty"`
+	ApiVersionDescription  *string     `json:"apiVersionDescription,omitempty"`
+	ApiVersionSetId        *string     `json:"apiVersionSetId,omitempty"`
+	Description            *string     `json:"description,omitempty"`
+	DisplayName            *string     `json:"displayName,omitempty"`
+	IsCurrent              *bool       `json:"isCurrent,omitempty"`
+	IsOnline               *bool       `json:"isOnline,omitempty"`
+	Path                   string      `json:"path"`
+	Protocols              *[]Protocol `json:"protocols,omitempty"`
+	ServiceUrl             *string     `json:"serviceUrl,omitempty"`
+	SourceApiId            *string     `json:"sourceApiId,omitempty"`
+	SubscriptionRequired   *bool       `json:"subscriptionRequired,omitempty"`
+	Type                   *ApiType    `json:"type,omitempty"`
+}
+
+type NamedValueContract struct {
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *NamedValueContractProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type NamedValueContractProperties struct {
+	DisplayName string    `json:"displayName"`
+	Secret      *bool     `json:"secret,omitempty"`
+	Tags        *[]string `json:"tags,omitempty"`
+	Value       *string   `json:"value,omitempty"`
+}
+
+type ProductContract struct {
+	Id         *string                    `json:"id,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *ProductContractProperties `json:"properties,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
+
+type ProductContractProperties struct {
+	ApprovalRequired     *bool         `json:"approvalRequired,omitempty"`
+	Description          *string       `json:"description,omitempty"`
+	DisplayName          string        `json:"displayName"`
+	State                *ProductState `json:"state,omitempty"`
+	SubscriptionRequired *bool         `json:"subscriptionRequired,omitempty"`
+	SubscriptionsLimit   *int64        `json:"subscriptionsLimit,omitempty"`
+	Terms                *string       `json:"terms,omitempty"`
+}
+
+type WorkspaceContract struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *WorkspaceContractProperties `json:"properties,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type WorkspaceContractProperties struct {
+	Description *string `json:"description,omitempty"`
+	DisplayName string  `json:"displayName"`
+}
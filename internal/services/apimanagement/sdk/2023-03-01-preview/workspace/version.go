@@ -0,0 +1,3 @@
+package workspace
+
+const defaultApiVersion = "2023-03-01-preview"
@@ -0,0 +1,183 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+)
+
+type WorkspaceGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *WorkspaceContract
+}
+
+// Get retrieves the API Management Workspace
+func (c WorkspaceClient) Get(ctx context.Context, id parse.WorkspaceId) (result WorkspaceGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type WorkspaceCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *WorkspaceContract
+}
+
+type WorkspaceCreateOrUpdateOperationOptions struct {
+	IfMatch *string
+}
+
+func DefaultWorkspaceCreateOrUpdateOperationOptions() WorkspaceCreateOrUpdateOperationOptions {
+	return WorkspaceCreateOrUpdateOperationOptions{}
+}
+
+func (o WorkspaceCreateOrUpdateOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceCreateOrUpdateOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceCreateOrUpdateOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	return &out
+}
+
+// CreateOrUpdate creates or updates the API Management Workspace
+func (c WorkspaceClient) CreateOrUpdate(ctx context.Context, id parse.WorkspaceId, input WorkspaceContract, options WorkspaceCreateOrUpdateOperationOptions) (result WorkspaceCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type WorkspaceDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type WorkspaceDeleteOperationOptions struct {
+	IfMatch *string
+}
+
+func DefaultWorkspaceDeleteOperationOptions() WorkspaceDeleteOperationOptions {
+	return WorkspaceDeleteOperationOptions{}
+}
+
+func (o WorkspaceDeleteOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceDeleteOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceDeleteOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	return &out
+}
+
+// Delete deletes the API Management Workspace
+func (c WorkspaceClient) Delete(ctx context.Context, id parse.WorkspaceId, options WorkspaceDeleteOperationOptions) (result WorkspaceDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
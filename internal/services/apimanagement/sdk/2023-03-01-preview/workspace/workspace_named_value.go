@@ -0,0 +1,201 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+)
+
+type WorkspaceNamedValueGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *NamedValueContract
+}
+
+// Get retrieves the Named Value within an API Management Workspace
+func (c WorkspaceNamedValueClient) Get(ctx context.Context, id parse.WorkspaceNamedValueId) (result WorkspaceNamedValueGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type WorkspaceNamedValueCreateOrUpdateOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type WorkspaceNamedValueCreateOrUpdateOperationOptions struct {
+	IfMatch *string
+}
+
+func DefaultWorkspaceNamedValueCreateOrUpdateOperationOptions() WorkspaceNamedValueCreateOrUpdateOperationOptions {
+	return WorkspaceNamedValueCreateOrUpdateOperationOptions{}
+}
+
+func (o WorkspaceNamedValueCreateOrUpdateOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceNamedValueCreateOrUpdateOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceNamedValueCreateOrUpdateOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	return &out
+}
+
+// CreateOrUpdate creates or updates the Named Value within an API Management Workspace
+func (c WorkspaceNamedValueClient) CreateOrUpdate(ctx context.Context, id parse.WorkspaceNamedValueId, input NamedValueContract, options WorkspaceNamedValueCreateOrUpdateOperationOptions) (result WorkspaceNamedValueCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll performs CreateOrUpdate then polls until it's completed
+func (c WorkspaceNamedValueClient) CreateOrUpdateThenPoll(ctx context.Context, id parse.WorkspaceNamedValueId, input NamedValueContract, options WorkspaceNamedValueCreateOrUpdateOperationOptions) error {
+	result, err := c.CreateOrUpdate(ctx, id, input, options)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+type WorkspaceNamedValueDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type WorkspaceNamedValueDeleteOperationOptions struct {
+	IfMatch *string
+}
+
+func DefaultWorkspaceNamedValueDeleteOperationOptions() WorkspaceNamedValueDeleteOperationOptions {
+	return WorkspaceNamedValueDeleteOperationOptions{}
+}
+
+func (o WorkspaceNamedValueDeleteOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceNamedValueDeleteOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceNamedValueDeleteOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	return &out
+}
+
+// Delete deletes the Named Value within an API Management Workspace
+func (c WorkspaceNamedValueClient) Delete(ctx context.Context, id parse.WorkspaceNamedValueId, options WorkspaceNamedValueDeleteOperationOptions) (result WorkspaceNamedValueDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -0,0 +1,187 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+)
+
+type WorkspaceProductGetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ProductContract
+}
+
+// Get retrieves the Product within an API Management Workspace
+func (c WorkspaceProductClient) Get(ctx context.Context, id parse.WorkspaceProductId) (result WorkspaceProductGetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type WorkspaceProductCreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ProductContract
+}
+
+type WorkspaceProductCreateOrUpdateOperationOptions struct {
+	IfMatch *string
+}
+
+func DefaultWorkspaceProductCreateOrUpdateOperationOptions() WorkspaceProductCreateOrUpdateOperationOptions {
+	return WorkspaceProductCreateOrUpdateOperationOptions{}
+}
+
+func (o WorkspaceProductCreateOrUpdateOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceProductCreateOrUpdateOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceProductCreateOrUpdateOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	return &out
+}
+
+// CreateOrUpdate creates or updates the Product within an API Management Workspace
+func (c WorkspaceProductClient) CreateOrUpdate(ctx context.Context, id parse.WorkspaceProductId, input ProductContract, options WorkspaceProductCreateOrUpdateOperationOptions) (result WorkspaceProductCreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodPut,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type WorkspaceProductDeleteOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+type WorkspaceProductDeleteOperationOptions struct {
+	DeleteSubscriptions *bool
+	IfMatch             *string
+}
+
+func DefaultWorkspaceProductDeleteOperationOptions() WorkspaceProductDeleteOperationOptions {
+	return WorkspaceProductDeleteOperationOptions{}
+}
+
+func (o WorkspaceProductDeleteOperationOptions) ToHeaders() *client.Headers {
+	out := client.Headers{}
+	if o.IfMatch != nil {
+		out.Append("If-Match", fmt.Sprintf("%v", *o.IfMatch))
+	}
+	return &out
+}
+
+func (o WorkspaceProductDeleteOperationOptions) ToOData() *odata.Query {
+	out := odata.Query{}
+	return &out
+}
+
+func (o WorkspaceProductDeleteOperationOptions) ToQuery() *client.QueryParams {
+	out := client.QueryParams{}
+	if o.DeleteSubscriptions != nil {
+		out.Append("deleteSubscriptions", fmt.Sprintf("%v", *o.DeleteSubscriptions))
+	}
+	return &out
+}
+
+// Delete deletes the Product within an API Management Workspace
+func (c WorkspaceProductClient) Delete(ctx context.Context, id parse.WorkspaceProductId, options WorkspaceProductDeleteOperationOptions) (result WorkspaceProductDeleteOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod:    http.MethodDelete,
+		Path:          id.ID(),
+		OptionsObject: options,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	return
+}
@@ -2,14 +2,19 @@ package helpers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -18,6 +23,233 @@ const (
 	zipDeployComplete = 4
 )
 
+// The artifact types supported by the OneDeploy (`/api/publish`) endpoint of the SCM site
+const (
+	OneDeployArtifactTypeEar    = "ear"
+	OneDeployArtifactTypeJar    = "jar"
+	OneDeployArtifactTypeStatic = "static"
+	OneDeployArtifactTypeWar    = "war"
+	OneDeployArtifactTypeZip    = "zip"
+)
+
+func PossibleValuesForOneDeployArtifactType() []string {
+	return []string{
+		OneDeployArtifactTypeEar,
+		OneDeployArtifactTypeJar,
+		OneDeployArtifactTypeStatic,
+		OneDeployArtifactTypeWar,
+		OneDeployArtifactTypeZip,
+	}
+}
+
+// PublishLocalFile deploys the local file `sourceFile` to the Site, using OneDeploy authenticated with the provider's credentials
+// when an `artifactType` is specified, otherwise falling back to a Kudu Zip Deploy using the Site Publishing Credentials
+func PublishLocalFile(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string, artifactType string) error {
+	if artifactType == "" {
+		return GetCredentialsAndPublish(ctx, client, resourceGroup, siteName, sourceFile)
+	}
+
+	site, err := client.Get(ctx, resourceGroup, siteName)
+	if err != nil || site.SiteProperties == nil {
+		return fmt.Errorf("reading site %s to perform OneDeploy: %+v", siteName, err)
+	}
+
+	host, err := scmHost(site.SiteProperties)
+	if err != nil {
+		return fmt.Errorf("could not determine SCM Site name for Site %s (Resource Group %s) for OneDeploy: %+v", siteName, resourceGroup, err)
+	}
+
+	if err := PublishOneDeployLocalFile(ctx, host, client.Authorizer, client.UserAgent, sourceFile, artifactType); err != nil {
+		return fmt.Errorf("publishing source (%s) to site %s (Resource Group %s): %+v", sourceFile, siteName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// PublishLocalFileSlot deploys the local file `sourceFile` to the Site Slot, see PublishLocalFile
+func PublishLocalFileSlot(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string, artifactType string, slotName string) error {
+	if artifactType == "" {
+		return GetCredentialsAndPublishSlot(ctx, client, resourceGroup, siteName, sourceFile, slotName)
+	}
+
+	site, err := client.GetSlot(ctx, resourceGroup, siteName, slotName)
+	if err != nil || site.SiteProperties == nil {
+		return fmt.Errorf("reading slot %s of site %s to perform OneDeploy: %+v", slotName, siteName, err)
+	}
+
+	host, err := scmHost(site.SiteProperties)
+	if err != nil {
+		return fmt.Errorf("could not determine SCM Site name for Slot %s of Site %s (Resource Group %s) for OneDeploy: %+v", slotName, siteName, resourceGroup, err)
+	}
+
+	if err := PublishOneDeployLocalFile(ctx, host, client.Authorizer, client.UserAgent, sourceFile, artifactType); err != nil {
+		return fmt.Errorf("publishing source (%s) to slot %s of site %s (Resource Group %s): %+v", sourceFile, slotName, siteName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func scmHost(props *web.SiteProperties) (string, error) {
+	if props.HostNameSslStates == nil {
+		return "", fmt.Errorf("no host names were returned")
+	}
+
+	for _, v := range *props.HostNameSslStates {
+		if v.Name != nil && *v.Name != "" && v.HostType == web.HostTypeRepository {
+			return fmt.Sprintf("https://%s", *v.Name), nil
+		}
+	}
+
+	return "", fmt.Errorf("no host name of type %q was returned", web.HostTypeRepository)
+}
+
+// LocalFileHash returns the SHA256 hash of the contents of the local file `path`, which is used to detect changes to a
+// deployment package which is rebuilt using the same file name
+func LocalFileHash(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("reading %s: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// SetZipDeployFileHash records the hash of the deployed `zip_deploy_file`, so that subsequent changes to its contents can be detected
+func SetZipDeployFileHash(d *pluginsdk.ResourceData, deployFile string) error {
+	hash, err := LocalFileHash(deployFile)
+	if err != nil {
+		return fmt.Errorf("calculating the hash of `zip_deploy_file`: %+v", err)
+	}
+
+	return d.Set("zip_deploy_file_hash", hash)
+}
+
+// ZipDeployFileHashForState returns the `zip_deploy_file_hash` to store in the state. Packages deployed before the hash was
+// tracked are assumed to match the current contents of the `zip_deploy_file`, rather than all being redeployed
+func ZipDeployFileHashForState(d *pluginsdk.ResourceData) string {
+	hash := d.Get("zip_deploy_file_hash").(string)
+	if deployFile := d.Get("zip_deploy_file").(string); hash == "" && deployFile != "" {
+		if fileHash, err := LocalFileHash(deployFile); err == nil {
+			hash = fileHash
+		}
+	}
+
+	return hash
+}
+
+// ZipDeployFileHashDiff plans a new deployment when the contents of the `zip_deploy_file` have changed since it was
+// last deployed, by comparing the hash of the file against the `zip_deploy_file_hash` stored in the state
+func ZipDeployFileHashDiff(rd *pluginsdk.ResourceDiff) error {
+	if !rd.NewValueKnown("zip_deploy_file") {
+		return rd.SetNewComputed("zip_deploy_file_hash")
+	}
+
+	deployFile := rd.Get("zip_deploy_file").(string)
+	if deployFile == "" {
+		return nil
+	}
+
+	hash, err := LocalFileHash(deployFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the package may be built during the apply, in which case the hash is only known once it's deployed
+			if rd.HasChange("zip_deploy_file") {
+				return rd.SetNewComputed("zip_deploy_file_hash")
+			}
+			return nil
+		}
+		return err
+	}
+
+	if hash != rd.Get("zip_deploy_file_hash").(string) {
+		return rd.SetNew("zip_deploy_file_hash", hash)
+	}
+
+	return nil
+}
+
+func PublishOneDeployLocalFile(ctx context.Context, host string, authorizer autorest.Authorizer, userAgent string, source string, artifactType string) error {
+	f, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	query := url.Values{}
+	query.Set("type", artifactType)
+	query.Set("async", "true")
+	if artifactType == OneDeployArtifactTypeStatic {
+		// static files are deployed to the given path relative to `wwwroot`
+		query.Set("path", filepath.Base(source))
+	}
+
+	publishEndpoint := fmt.Sprintf("%s/api/publish?%s", host, query.Encode())
+	statusEndpoint := fmt.Sprintf("%s/api/deployments/latest", host)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, publishEndpoint, f)
+	if err != nil {
+		return fmt.Errorf("preparing publish request: %+v", err)
+	}
+
+	req.Header["Cache-Control"] = []string{"no-cache"}
+	req.Header["User-Agent"] = []string{userAgent}
+	req.Header["Content-Type"] = []string{"application/octet-stream"}
+
+	// the SCM site accepts a bearer token for the Resource Manager audience, so this works when basic auth is disabled
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		return fmt.Errorf("authorizing publish request: %+v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending publish request: %+v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
+		if resp.StatusCode == http.StatusConflict {
+			return fmt.Errorf("publishing OneDeploy Deployment failed with %s - Another operation is in progress", resp.Status)
+		}
+		return fmt.Errorf("publishing failed with status code %s", resp.Status)
+	}
+
+	statusReq, err := http.NewRequestWithContext(ctx, http.MethodGet, statusEndpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	statusReq, err = autorest.Prepare(statusReq, authorizer.WithAuthorization())
+	if err != nil {
+		return fmt.Errorf("authorizing deployment status request: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("publish request context had no deadline")
+	}
+
+	deployWait := &pluginsdk.StateChangeConf{
+		Pending:      []string{"pending"},
+		Target:       []string{"complete"},
+		PollInterval: 10 * time.Second,
+		Delay:        10 * time.Second,
+		Timeout:      time.Until(deadline),
+		Refresh:      checkZipDeploymentStatusRefresh(statusReq),
+	}
+
+	if _, err := deployWait.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for OneDeploy Deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
 func GetCredentialsAndPublish(ctx context.Context, client *web.AppsClient, resourceGroup string, siteName string, sourceFile string) error {
 	site, err := client.Get(ctx, resourceGroup, siteName)
 	if err != nil || site.SiteProperties == nil {
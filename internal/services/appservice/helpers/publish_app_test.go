@@ -0,0 +1,47 @@
+package helpers_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+)
+
+func TestLocalFileHash(t *testing.T) {
+	dir := t.TempDir()
+
+	input := []struct {
+		content  string
+		expected string
+	}{
+		{
+			content:  "",
+			expected: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		},
+		{
+			content:  "hello world",
+			expected: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		},
+	}
+
+	for i, v := range input {
+		path := filepath.Join(dir, "package.zip")
+		if err := os.WriteFile(path, []byte(v.content), 0600); err != nil {
+			t.Fatalf("writing test file %d: %+v", i, err)
+		}
+
+		actual, err := helpers.LocalFileHash(path)
+		if err != nil {
+			t.Fatalf("unexpected error for test %d: %+v", i, err)
+		}
+
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q for test %d", v.expected, actual, i)
+		}
+	}
+
+	if _, err := helpers.LocalFileHash(filepath.Join(dir, "missing.zip")); !os.IsNotExist(err) {
+		t.Fatalf("expected a not exist error for a missing file but got %+v", err)
+	}
+}
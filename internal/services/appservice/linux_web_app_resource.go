@@ -47,7 +47,9 @@ type LinuxWebAppModel struct {
 	SiteConfig                    []helpers.SiteConfigLinux  `tfschema:"site_config"`
 	StorageAccounts               []helpers.StorageAccount   `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString `tfschema:"connection_string"`
+	ZipDeployArtifactType         string                     `tfschema:"zip_deploy_artifact_type"`
 	ZipDeployFile                 string                     `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                     `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string          `tfschema:"tags"`
 	CustomDomainVerificationId    string                     `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                     `tfschema:"default_hostname"`
@@ -61,6 +63,8 @@ type LinuxWebAppModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppResource{}
+
 var _ sdk.ResourceWithCustomImporter = LinuxWebAppResource{}
 
 func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
@@ -164,12 +168,20 @@ func (r LinuxWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchema(),
 
+		"zip_deploy_artifact_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(helpers.PossibleValuesForOneDeployArtifactType(), false),
+			RequiredWith: []string{"zip_deploy_file"},
+			Description:  "The type of the artifact in `zip_deploy_file`, which is deployed using OneDeploy authenticated with the credentials of the provider rather than the SCM basic auth publishing credentials. Possible values are `ear`, `jar`, `static`, `war` and `zip`.",
+		},
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the packaged application to deploy to this Linux Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
 		},
 
 		"tags": tags.Schema(),
@@ -223,6 +235,11 @@ func (r LinuxWebAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -425,7 +442,11 @@ func (r LinuxWebAppResource) Create() sdk.ResourceFunc {
 			}
 
 			if webApp.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile); err != nil {
+				if err = helpers.PublishLocalFile(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile, webApp.ZipDeployArtifactType); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, webApp.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -580,6 +601,8 @@ func (r LinuxWebAppResource) Read() sdk.ResourceFunc {
 			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 				state.ZipDeployFile = deployFile
 			}
+			state.ZipDeployArtifactType = metadata.ResourceData.Get("zip_deploy_artifact_type").(string)
+			state.ZipDeployFileHash = helpers.ZipDeployFileHashForState(metadata.ResourceData)
 
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
@@ -841,8 +864,12 @@ func (r LinuxWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash", "zip_deploy_artifact_type") {
+				if err = helpers.PublishLocalFile(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, state.ZipDeployArtifactType); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, state.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -885,3 +912,12 @@ func (r LinuxWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 		return nil
 	}
 }
+
+func (r LinuxWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashDiff(metadata.ResourceDiff)
+		},
+	}
+}
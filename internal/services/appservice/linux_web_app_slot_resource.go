@@ -45,7 +45,9 @@ type LinuxWebAppSlotModel struct {
 	SiteConfig                    []helpers.SiteConfigLinuxWebAppSlot `tfschema:"site_config"`
 	StorageAccounts               []helpers.StorageAccount            `tfschema:"storage_account"`
 	ConnectionStrings             []helpers.ConnectionString          `tfschema:"connection_string"`
+	ZipDeployArtifactType         string                              `tfschema:"zip_deploy_artifact_type"`
 	ZipDeployFile                 string                              `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                              `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string                   `tfschema:"tags"`
 	CustomDomainVerificationId    string                              `tfschema:"custom_domain_verification_id"`
 	DefaultHostname               string                              `tfschema:"default_hostname"`
@@ -60,6 +62,8 @@ type LinuxWebAppSlotModel struct {
 
 var _ sdk.ResourceWithUpdate = LinuxWebAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = LinuxWebAppSlotResource{}
+
 func (r LinuxWebAppSlotResource) ModelObject() interface{} {
 	return &LinuxWebAppSlotModel{}
 }
@@ -173,12 +177,20 @@ func (r LinuxWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchema(),
 
+		"zip_deploy_artifact_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(helpers.PossibleValuesForOneDeployArtifactType(), false),
+			RequiredWith: []string{"zip_deploy_file"},
+			Description:  "The type of the artifact in `zip_deploy_file`, which is deployed using OneDeploy authenticated with the credentials of the provider rather than the SCM basic auth publishing credentials. Possible values are `ear`, `jar`, `static`, `war` and `zip`.",
+		},
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the packaged application to deploy to this Linux Web App Slot. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
 		},
 
 		"tags": tags.Schema(),
@@ -238,6 +250,11 @@ func (r LinuxWebAppSlotResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -404,7 +421,11 @@ func (r LinuxWebAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			if webAppSlot.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile); err != nil {
+				if err = helpers.PublishLocalFileSlot(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile, webAppSlot.ZipDeployArtifactType, id.SlotName); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, webAppSlot.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -568,6 +589,8 @@ func (r LinuxWebAppSlotResource) Read() sdk.ResourceFunc {
 			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 				state.ZipDeployFile = deployFile
 			}
+			state.ZipDeployArtifactType = metadata.ResourceData.Get("zip_deploy_artifact_type").(string)
+			state.ZipDeployFileHash = helpers.ZipDeployFileHashForState(metadata.ResourceData)
 
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
@@ -798,8 +821,12 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash", "zip_deploy_artifact_type") {
+				if err = helpers.PublishLocalFileSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, state.ZipDeployArtifactType, id.SlotName); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, state.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -808,3 +835,12 @@ func (r LinuxWebAppSlotResource) Update() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r LinuxWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashDiff(metadata.ResourceDiff)
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WebAppSlotSwapId is the ID of a swap of a Web App Slot with Production, which isn't a resource within Azure and so
+// is represented as the ID of the Slot with a `/swap` suffix
+type WebAppSlotSwapId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	SiteName       string
+	SlotName       string
+}
+
+const webAppSlotSwapIdSuffix = "/swap"
+
+func NewWebAppSlotSwapID(subscriptionId, resourceGroup, siteName, slotName string) WebAppSlotSwapId {
+	return WebAppSlotSwapId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		SiteName:       siteName,
+		SlotName:       slotName,
+	}
+}
+
+func (id WebAppSlotSwapId) String() string {
+	segments := []string{
+		fmt.Sprintf("Slot Name %q", id.SlotName),
+		fmt.Sprintf("Site Name %q", id.SiteName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Web App Slot Swap", segmentsStr)
+}
+
+func (id WebAppSlotSwapId) ID() string {
+	return id.SlotID().ID() + webAppSlotSwapIdSuffix
+}
+
+func (id WebAppSlotSwapId) SlotID() WebAppSlotId {
+	return NewWebAppSlotID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+}
+
+// WebAppSlotSwapID parses a WebAppSlotSwap ID into an WebAppSlotSwapId struct
+func WebAppSlotSwapID(input string) (*WebAppSlotSwapId, error) {
+	if !strings.HasSuffix(input, webAppSlotSwapIdSuffix) {
+		return nil, fmt.Errorf("could not parse Web App Slot Swap ID, expected {slotId}%s, got %s", webAppSlotSwapIdSuffix, input)
+	}
+
+	slotId, err := WebAppSlotID(strings.TrimSuffix(input, webAppSlotSwapIdSuffix))
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewWebAppSlotSwapID(slotId.SubscriptionId, slotId.ResourceGroup, slotId.SiteName, slotId.SlotName)
+	return &id, nil
+}
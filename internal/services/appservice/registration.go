@@ -46,6 +46,7 @@ func (r Registration) Resources() []sdk.Resource {
 		SourceControlSlotResource{},
 		WebAppActiveSlotResource{},
 		WebAppHybridConnectionResource{},
+		WebAppSlotSwapResource{},
 		WindowsFunctionAppResource{},
 		WindowsFunctionAppSlotResource{},
 		WindowsWebAppResource{},
@@ -0,0 +1,415 @@
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/web/mgmt/2021-03-01/web" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+const webAppProductionSlotName = "production"
+
+type WebAppSlotSwapResource struct{}
+
+type WebAppSlotSwapModel struct {
+	SlotID              string                           `tfschema:"slot_id"`
+	OverwriteNetworking bool                             `tfschema:"overwrite_network_config"`
+	SwapWithPreview     bool                             `tfschema:"swap_with_preview"`
+	WarmUpDuration      int                              `tfschema:"warm_up_duration_in_seconds"`
+	HealthProbe         []WebAppSlotSwapHealthProbeModel `tfschema:"health_probe"`
+	RollbackOnDestroy   bool                             `tfschema:"rollback_on_destroy"`
+	LastSwap            string                           `tfschema:"last_successful_swap"`
+}
+
+type WebAppSlotSwapHealthProbeModel struct {
+	Path                string `tfschema:"path"`
+	ExpectedStatusCodes []int  `tfschema:"expected_status_codes"`
+	Attempts            int    `tfschema:"attempts"`
+	IntervalInSeconds   int    `tfschema:"interval_in_seconds"`
+}
+
+var _ sdk.ResourceWithUpdate = WebAppSlotSwapResource{}
+
+func (r WebAppSlotSwapResource) ModelObject() interface{} {
+	return &WebAppSlotSwapModel{}
+}
+
+func (r WebAppSlotSwapResource) ResourceType() string {
+	return "azurerm_web_app_slot_swap"
+}
+
+func (r WebAppSlotSwapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.WebAppSlotSwapID
+}
+
+func (r WebAppSlotSwapResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"slot_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "The ID of the Slot to swap into `Production`.",
+			ValidateFunc: validate.WebAppSlotID,
+		},
+
+		"overwrite_network_config": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "The swap action should overwrite the Production slot's network configuration with the configuration from this slot. Defaults to `true`.",
+		},
+
+		"swap_with_preview": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Should the swap be performed in multiple phases, by first applying the configuration of `Production` to the Slot so that it can be warmed up and probed before the swap is completed? Defaults to `false`.",
+		},
+
+		"warm_up_duration_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 3600),
+			Description:  "The number of seconds to wait after applying the configuration of `Production` to the Slot before probing it and completing the swap. Only used when `swap_with_preview` is `true`.",
+		},
+
+		"health_probe": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/`), "`path` must start with `/`"),
+						Description:  "The path on the App to send the HTTP health probe to, for example `/health`.",
+					},
+
+					"expected_status_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeInt,
+							ValidateFunc: validation.IntBetween(100, 599),
+						},
+						Description: "The HTTP status codes which indicate the App is healthy. Defaults to `[200]`.",
+					},
+
+					"attempts": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 100),
+						Description:  "The number of times the health probe is attempted before the App is considered unhealthy.",
+					},
+
+					"interval_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      10,
+						ValidateFunc: validation.IntBetween(1, 300),
+						Description:  "The number of seconds between attempts of the health probe.",
+					},
+				},
+			},
+		},
+
+		"rollback_on_destroy": {
+			Type:        pluginsdk.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Should the swap be reverted, restoring the previous `Production` content, when this resource is destroyed? Defaults to `true`.",
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_successful_swap": {
+			Type:        pluginsdk.TypeString,
+			Computed:    true,
+			Description: "The timestamp of the last successful swap with `Production`.",
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var swap WebAppSlotSwapModel
+			if err := metadata.Decode(&swap); err != nil {
+				return err
+			}
+
+			id, err := parse.WebAppSlotID(swap.SlotID)
+			if err != nil {
+				return err
+			}
+			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			slot, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(slot.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			if err := r.swap(ctx, client, *id, swap); err != nil {
+				return err
+			}
+
+			swapId := parse.NewWebAppSlotSwapID(id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
+			metadata.SetID(swapId)
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			swapId, err := parse.WebAppSlotSwapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := swapId.SlotID()
+
+			slot, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				if utils.ResponseWasNotFound(slot.Response) {
+					return metadata.MarkAsGone(swapId)
+				}
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			var state WebAppSlotSwapModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the swap itself can't be read back from the service, so the configuration is retained - using the defaults when importing
+			if _, ok := metadata.ResourceData.GetOk("slot_id"); !ok {
+				state.OverwriteNetworking = true
+				state.RollbackOnDestroy = true
+			}
+			state.SlotID = id.ID()
+			state.LastSwap = ""
+
+			app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName), err)
+			}
+			if props := app.SiteProperties; props != nil && props.SlotSwapStatus != nil && props.SlotSwapStatus.TimestampUtc != nil {
+				state.LastSwap = props.SlotSwapStatus.TimestampUtc.String()
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the remaining arguments only control how a swap is performed, so there is nothing to update in Azure
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var swap WebAppSlotSwapModel
+			if err := metadata.Decode(&swap); err != nil {
+				return err
+			}
+
+			if !swap.RollbackOnDestroy {
+				return nil
+			}
+
+			swapId, err := parse.WebAppSlotSwapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+			id := swapId.SlotID()
+			appId := parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			locks.ByID(appId.ID())
+			defer locks.UnlockByID(appId.ID())
+
+			// the previous content of `Production` now lives in the swapped Slot, so swapping again restores it
+			log.Printf("[DEBUG] rolling back the swap of %s with Production", id)
+			if err := swapWebAppSlotWithProduction(ctx, client, id, swap.OverwriteNetworking); err != nil {
+				return fmt.Errorf("rolling back: %+v", err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WebAppSlotSwapResource) swap(ctx context.Context, client *web.AppsClient, id parse.WebAppSlotId, swap WebAppSlotSwapModel) error {
+	if swap.SwapWithPreview {
+		// phase one applies the (sticky) configuration of `Production` to the Slot, which restarts the Slot
+		log.Printf("[DEBUG] applying the configuration of Production to %s", id)
+		entity := web.CsmSlotEntity{
+			TargetSlot:   utils.String(webAppProductionSlotName),
+			PreserveVnet: utils.Bool(swap.OverwriteNetworking),
+		}
+		if _, err := client.ApplySlotConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, entity, id.SlotName); err != nil {
+			return fmt.Errorf("applying the configuration of Production to %s: %+v", id, err)
+		}
+
+		if swap.WarmUpDuration > 0 {
+			log.Printf("[DEBUG] waiting %d seconds for %s to warm up", swap.WarmUpDuration, id)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("waiting for %s to warm up: %+v", id, ctx.Err())
+			case <-time.After(time.Duration(swap.WarmUpDuration) * time.Second):
+			}
+		}
+
+		if len(swap.HealthProbe) > 0 {
+			slot, err := client.GetSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName)
+			if err != nil {
+				return fmt.Errorf("reading %s: %+v", id, err)
+			}
+
+			if err := probeWebAppSlotSwapHealth(ctx, slot.SiteProperties, swap.HealthProbe[0]); err != nil {
+				// cancelling restores the original configuration of the Slot, leaving `Production` untouched
+				if _, resetErr := client.ResetSlotConfigurationSlot(ctx, id.ResourceGroup, id.SiteName, id.SlotName); resetErr != nil {
+					return fmt.Errorf("cancelling the swap of %s after the health probe failed (%+v): %+v", id, err, resetErr)
+				}
+				return fmt.Errorf("cancelled the swap of %s since the health probe failed: %+v", id, err)
+			}
+		}
+	}
+
+	// completing the swap with preview is the same operation as a regular swap
+	if err := swapWebAppSlotWithProduction(ctx, client, id, swap.OverwriteNetworking); err != nil {
+		return err
+	}
+
+	if len(swap.HealthProbe) > 0 {
+		app, err := client.Get(ctx, id.ResourceGroup, id.SiteName)
+		if err != nil {
+			return fmt.Errorf("reading %s: %+v", parse.NewWebAppID(id.SubscriptionId, id.ResourceGroup, id.SiteName), err)
+		}
+
+		if err := probeWebAppSlotSwapHealth(ctx, app.SiteProperties, swap.HealthProbe[0]); err != nil {
+			log.Printf("[DEBUG] health probe of Production failed after swapping %s - rolling back", id)
+			if rollbackErr := swapWebAppSlotWithProduction(ctx, client, id, swap.OverwriteNetworking); rollbackErr != nil {
+				return fmt.Errorf("rolling back the swap of %s after the health probe failed (%+v): %+v", id, err, rollbackErr)
+			}
+			return fmt.Errorf("rolled back the swap of %s since the health probe of Production failed: %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func swapWebAppSlotWithProduction(ctx context.Context, client *web.AppsClient, id parse.WebAppSlotId, overwriteNetworking bool) error {
+	entity := web.CsmSlotEntity{
+		TargetSlot:   utils.String(id.SlotName),
+		PreserveVnet: utils.Bool(overwriteNetworking),
+	}
+
+	future, err := client.SwapSlotWithProduction(ctx, id.ResourceGroup, id.SiteName, entity)
+	if err != nil {
+		return fmt.Errorf("swapping %s with Production: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the swap of %s with Production to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func probeWebAppSlotSwapHealth(ctx context.Context, props *web.SiteProperties, probe WebAppSlotSwapHealthProbeModel) error {
+	if props == nil || props.DefaultHostName == nil {
+		return fmt.Errorf("could not determine the default host name to probe")
+	}
+
+	expectedStatusCodes := probe.ExpectedStatusCodes
+	if len(expectedStatusCodes) == 0 {
+		expectedStatusCodes = []int{http.StatusOK}
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", *props.DefaultHostName, probe.Path)
+
+	var lastErr error
+	for attempt := 1; attempt <= probe.Attempts; attempt++ {
+		lastErr = probeWebAppSlotSwapEndpoint(ctx, endpoint, expectedStatusCodes)
+		if lastErr == nil {
+			return nil
+		}
+		log.Printf("[DEBUG] health probe attempt %d/%d of %q failed: %+v", attempt, probe.Attempts, endpoint, lastErr)
+
+		if attempt < probe.Attempts {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Duration(probe.IntervalInSeconds) * time.Second):
+			}
+		}
+	}
+
+	return fmt.Errorf("probing %q: %+v", endpoint, lastErr)
+}
+
+func probeWebAppSlotSwapEndpoint(ctx context.Context, endpoint string, expectedStatusCodes []int) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for _, v := range expectedStatusCodes {
+		if resp.StatusCode == v {
+			return nil
+		}
+	}
+
+	codes := make([]string, 0)
+	for _, v := range expectedStatusCodes {
+		codes = append(codes, fmt.Sprintf("%d", v))
+	}
+	return fmt.Errorf("unexpected status %q, expected one of %s", resp.Status, strings.Join(codes, ", "))
+}
@@ -0,0 +1,177 @@
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WebAppSlotSwapResource struct{}
+
+func TestAccWebAppSlotSwap_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	r := WebAppSlotSwapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_successful_swap").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWebAppSlotSwap_withPreview(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	r := WebAppSlotSwapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withPreview(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("swap_with_preview", "warm_up_duration_in_seconds", "health_probe", "rollback_on_destroy"),
+	})
+}
+
+func TestAccWebAppSlotSwap_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_app_slot_swap", "test")
+	r := WebAppSlotSwapResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withPreview(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("swap_with_preview", "warm_up_duration_in_seconds", "health_probe", "rollback_on_destroy"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r WebAppSlotSwapResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	swapId, err := parse.WebAppSlotSwapID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	id := swapId.SlotID()
+
+	app, err := client.AppService.WebAppsClient.Get(ctx, id.ResourceGroup, id.SiteName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Web App for %s: %+v", id, err)
+	}
+	if app.SiteProperties == nil || app.SiteProperties.SlotSwapStatus == nil || app.SiteProperties.SlotSwapStatus.SourceSlotName == nil {
+		return utils.Bool(false), nil
+	}
+
+	return utils.Bool(*app.SiteProperties.SlotSwapStatus.SourceSlotName == id.SlotName), nil
+}
+
+func (r WebAppSlotSwapResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_slot_swap" "test" {
+  slot_id = azurerm_linux_web_app_slot.test.id
+}
+`, r.template(data))
+}
+
+func (r WebAppSlotSwapResource) withPreview(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_web_app_slot_swap" "test" {
+  slot_id                     = azurerm_linux_web_app_slot.test.id
+  swap_with_preview           = true
+  warm_up_duration_in_seconds = 30
+  rollback_on_destroy         = false
+
+  health_probe {
+    path                  = "/"
+    expected_status_codes = [200]
+    attempts              = 5
+    interval_in_seconds   = 15
+  }
+}
+`, r.template(data))
+}
+
+func (WebAppSlotSwapResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_service_plan" "test" {
+  name                = "acctestASP-WAS-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  os_type             = "Linux"
+  sku_name            = "S1"
+}
+
+resource "azurerm_linux_web_app" "test" {
+  name                = "acctestWA-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  service_plan_id     = azurerm_service_plan.test.id
+
+  app_settings = {
+    "ENVIRONMENT" = "production"
+  }
+
+  sticky_settings {
+    app_setting_names = ["ENVIRONMENT"]
+  }
+
+  site_config {}
+}
+
+resource "azurerm_linux_web_app_slot" "test" {
+  name           = "acctestWAS-%[1]d"
+  app_service_id = azurerm_linux_web_app.test.id
+
+  app_settings = {
+    "ENVIRONMENT" = "staging"
+  }
+
+  site_config {}
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -54,13 +54,17 @@ type WindowsWebAppModel struct {
 	PossibleOutboundIPAddresses   string                      `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string                    `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential    `tfschema:"site_credential"`
+	ZipDeployArtifactType         string                      `tfschema:"zip_deploy_artifact_type"`
 	ZipDeployFile                 string                      `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                      `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string           `tfschema:"tags"`
 	VirtualNetworkSubnetID        string                      `tfschema:"virtual_network_subnet_id"`
 }
 
 var _ sdk.ResourceWithCustomImporter = WindowsWebAppResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppResource{}
+
 func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"name": {
@@ -156,12 +160,20 @@ func (r WindowsWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
+		"zip_deploy_artifact_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(helpers.PossibleValuesForOneDeployArtifactType(), false),
+			RequiredWith: []string{"zip_deploy_file"},
+			Description:  "The type of the artifact in `zip_deploy_file`, which is deployed using OneDeploy authenticated with the credentials of the provider rather than the SCM basic auth publishing credentials. Possible values are `ear`, `jar`, `static`, `war` and `zip`.",
+		},
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
 		},
 
 		"tags": tags.Schema(),
@@ -222,6 +234,11 @@ func (r WindowsWebAppResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -440,7 +457,11 @@ func (r WindowsWebAppResource) Create() sdk.ResourceFunc {
 			}
 
 			if webApp.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile); err != nil {
+				if err = helpers.PublishLocalFile(ctx, client, id.ResourceGroup, id.SiteName, webApp.ZipDeployFile, webApp.ZipDeployArtifactType); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, webApp.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -612,6 +633,8 @@ func (r WindowsWebAppResource) Read() sdk.ResourceFunc {
 			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 				state.ZipDeployFile = deployFile
 			}
+			state.ZipDeployArtifactType = metadata.ResourceData.Get("zip_deploy_artifact_type").(string)
+			state.ZipDeployFileHash = helpers.ZipDeployFileHashForState(metadata.ResourceData)
 
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
@@ -885,8 +908,12 @@ func (r WindowsWebAppResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublish(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile); err != nil {
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash", "zip_deploy_artifact_type") {
+				if err = helpers.PublishLocalFile(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, state.ZipDeployArtifactType); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, state.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -929,3 +956,12 @@ func (r WindowsWebAppResource) CustomImporter() sdk.ResourceRunFunc {
 		return nil
 	}
 }
+
+func (r WindowsWebAppResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashDiff(metadata.ResourceDiff)
+		},
+	}
+}
@@ -52,13 +52,17 @@ type WindowsWebAppSlotModel struct {
 	PossibleOutboundIPAddresses   string                                `tfschema:"possible_outbound_ip_addresses"`
 	PossibleOutboundIPAddressList []string                              `tfschema:"possible_outbound_ip_address_list"`
 	SiteCredentials               []helpers.SiteCredential              `tfschema:"site_credential"`
+	ZipDeployArtifactType         string                                `tfschema:"zip_deploy_artifact_type"`
 	ZipDeployFile                 string                                `tfschema:"zip_deploy_file"`
+	ZipDeployFileHash             string                                `tfschema:"zip_deploy_file_hash"`
 	Tags                          map[string]string                     `tfschema:"tags"`
 	VirtualNetworkSubnetID        string                                `tfschema:"virtual_network_subnet_id"`
 }
 
 var _ sdk.ResourceWithUpdate = WindowsWebAppSlotResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = WindowsWebAppSlotResource{}
+
 func (r WindowsWebAppSlotResource) ModelObject() interface{} {
 	return &WindowsWebAppSlotModel{}
 }
@@ -167,12 +171,20 @@ func (r WindowsWebAppSlotResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"storage_account": helpers.StorageAccountSchemaWindows(),
 
+		"zip_deploy_artifact_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(helpers.PossibleValuesForOneDeployArtifactType(), false),
+			RequiredWith: []string{"zip_deploy_file"},
+			Description:  "The type of the artifact in `zip_deploy_file`, which is deployed using OneDeploy authenticated with the credentials of the provider rather than the SCM basic auth publishing credentials. Possible values are `ear`, `jar`, `static`, `war` and `zip`.",
+		},
+
 		"zip_deploy_file": {
 			Type:         pluginsdk.TypeString,
 			Optional:     true,
 			Computed:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
-			Description:  "The local path and filename of the Zip packaged application to deploy to this Windows Web App. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
+			Description:  "The local path and filename of the packaged application to deploy to this Windows Web App Slot. **Note:** Using this value requires `WEBSITE_RUN_FROM_PACKAGE=1` on the App in `app_settings`.",
 		},
 
 		"tags": tags.Schema(),
@@ -230,6 +242,11 @@ func (r WindowsWebAppSlotResource) Attributes() map[string]*pluginsdk.Schema {
 		},
 
 		"site_credential": helpers.SiteCredentialSchema(),
+
+		"zip_deploy_file_hash": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -406,7 +423,11 @@ func (r WindowsWebAppSlotResource) Create() sdk.ResourceFunc {
 			}
 
 			if webAppSlot.ZipDeployFile != "" {
-				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile, id.SlotName); err != nil {
+				if err = helpers.PublishLocalFileSlot(ctx, client, id.ResourceGroup, id.SiteName, webAppSlot.ZipDeployFile, webAppSlot.ZipDeployArtifactType, id.SlotName); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, webAppSlot.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -586,6 +607,8 @@ func (r WindowsWebAppSlotResource) Read() sdk.ResourceFunc {
 			if deployFile, ok := metadata.ResourceData.Get("zip_deploy_file").(string); ok {
 				state.ZipDeployFile = deployFile
 			}
+			state.ZipDeployArtifactType = metadata.ResourceData.Get("zip_deploy_artifact_type").(string)
+			state.ZipDeployFileHash = helpers.ZipDeployFileHashForState(metadata.ResourceData)
 
 			if err := metadata.Encode(&state); err != nil {
 				return fmt.Errorf("encoding: %+v", err)
@@ -830,8 +853,12 @@ func (r WindowsWebAppSlotResource) Update() sdk.ResourceFunc {
 				}
 			}
 
-			if metadata.ResourceData.HasChange("zip_deploy_file") {
-				if err = helpers.GetCredentialsAndPublishSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, id.SlotName); err != nil {
+			if metadata.ResourceData.HasChanges("zip_deploy_file", "zip_deploy_file_hash", "zip_deploy_artifact_type") {
+				if err = helpers.PublishLocalFileSlot(ctx, client, id.ResourceGroup, id.SiteName, state.ZipDeployFile, state.ZipDeployArtifactType, id.SlotName); err != nil {
+					return err
+				}
+
+				if err := helpers.SetZipDeployFileHash(metadata.ResourceData, state.ZipDeployFile); err != nil {
 					return err
 				}
 			}
@@ -840,3 +867,12 @@ func (r WindowsWebAppSlotResource) Update() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r WindowsWebAppSlotResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			return helpers.ZipDeployFileHashDiff(metadata.ResourceDiff)
+		},
+	}
+}
@@ -0,0 +1,117 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+)
+
+// the Rules within a Role Management Policy are polymorphic, however the SDK unmarshals them into a
+// type which can't be accessed or marshalled back - so we work with the raw Rules until that's fixed
+
+const roleManagementPoliciesApiVersion = "2020-10-01"
+
+type RoleManagementPoliciesWorkaroundClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewRoleManagementPoliciesWorkaroundClientWithBaseURI(endpoint string) RoleManagementPoliciesWorkaroundClient {
+	return RoleManagementPoliciesWorkaroundClient{
+		Client:  rolemanagementpolicies.NewRoleManagementPoliciesClientWithBaseURI(endpoint).Client,
+		baseUri: endpoint,
+	}
+}
+
+type RoleManagementPolicyResponse struct {
+	HttpResponse *http.Response
+	Model        *RoleManagementPolicy
+}
+
+// Get retrieves the specified Role Management Policy, including the Rules within it.
+func (c RoleManagementPoliciesWorkaroundClient) Get(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId) (result RoleManagementPolicyResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Get", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responder(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Get", result.HttpResponse, "Failure responding to request")
+	}
+
+	return
+}
+
+// Update patches the specified Role Management Policy - only the Rules which are specified are updated.
+func (c RoleManagementPoliciesWorkaroundClient) Update(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId, input RoleManagementPolicy) (result RoleManagementPolicyResponse, err error) {
+	req, err := c.prepare(ctx, id, autorest.AsPatch(), autorest.AsContentType("application/json; charset=utf-8"), autorest.WithJSON(input))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Update", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Update", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responder(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "rolemanagementpolicies.RoleManagementPoliciesClient", "Update", result.HttpResponse, "Failure responding to request")
+	}
+
+	return
+}
+
+func (c RoleManagementPoliciesWorkaroundClient) prepare(ctx context.Context, id rolemanagementpolicies.ScopedRoleManagementPolicyId, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": roleManagementPoliciesApiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+func (c RoleManagementPoliciesWorkaroundClient) responder(resp *http.Response) (result RoleManagementPolicyResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
+
+type RoleManagementPolicy struct {
+	Id         *string                         `json:"id,omitempty"`
+	Name       *string                         `json:"name,omitempty"`
+	Properties *RoleManagementPolicyProperties `json:"properties,omitempty"`
+	Type       *string                         `json:"type,omitempty"`
+}
+
+type RoleManagementPolicyProperties struct {
+	Description           *string                     `json:"description,omitempty"`
+	DisplayName           *string                     `json:"displayName,omitempty"`
+	IsOrganizationDefault *bool                       `json:"isOrganizationDefault,omitempty"`
+	Rules                 *[]RoleManagementPolicyRule `json:"rules,omitempty"`
+	Scope                 *string                     `json:"scope,omitempty"`
+}
+
+// RoleManagementPolicyRule is the raw representation of a Rule, identified by it's `id` and `ruleType`
+type RoleManagementPolicyRule map[string]interface{}
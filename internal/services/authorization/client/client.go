@@ -2,23 +2,59 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2020-04-01-preview/authorization" // nolint: staticcheck // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedulerequests"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
 )
 
 type Client struct {
-	RoleAssignmentsClient *authorization.RoleAssignmentsClient
-	RoleDefinitionsClient *authorization.RoleDefinitionsClient
+	RoleAssignmentsClient                 *authorization.RoleAssignmentsClient
+	RoleAssignmentScheduleRequestsClient  *roleassignmentschedulerequests.RoleAssignmentScheduleRequestsClient
+	RoleAssignmentSchedulesClient         *roleassignmentschedules.RoleAssignmentSchedulesClient
+	RoleDefinitionsClient                 *authorization.RoleDefinitionsClient
+	RoleEligibilityScheduleRequestsClient *roleeligibilityschedulerequests.RoleEligibilityScheduleRequestsClient
+	RoleEligibilitySchedulesClient        *roleeligibilityschedules.RoleEligibilitySchedulesClient
+	RoleManagementPoliciesClient          *azuresdkhacks.RoleManagementPoliciesWorkaroundClient
+	RoleManagementPolicyAssignmentsClient *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
 	roleAssignmentsClient := authorization.NewRoleAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleAssignmentsClient.Client, o.ResourceManagerAuthorizer)
 
+	roleAssignmentScheduleRequestsClient := roleassignmentschedulerequests.NewRoleAssignmentScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleAssignmentScheduleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleAssignmentSchedulesClient := roleassignmentschedules.NewRoleAssignmentSchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleAssignmentSchedulesClient.Client, o.ResourceManagerAuthorizer)
+
 	roleDefinitionsClient := authorization.NewRoleDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&roleDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
+	roleEligibilityScheduleRequestsClient := roleeligibilityschedulerequests.NewRoleEligibilityScheduleRequestsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilityScheduleRequestsClient.Client, o.ResourceManagerAuthorizer)
+
+	roleEligibilitySchedulesClient := roleeligibilityschedules.NewRoleEligibilitySchedulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleEligibilitySchedulesClient.Client, o.ResourceManagerAuthorizer)
+
+	roleManagementPoliciesClient := azuresdkhacks.NewRoleManagementPoliciesWorkaroundClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
+	roleManagementPolicyAssignmentsClient := rolemanagementpolicyassignments.NewRoleManagementPolicyAssignmentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&roleManagementPolicyAssignmentsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		RoleAssignmentsClient: &roleAssignmentsClient,
-		RoleDefinitionsClient: &roleDefinitionsClient,
+		RoleAssignmentsClient:                 &roleAssignmentsClient,
+		RoleAssignmentScheduleRequestsClient:  &roleAssignmentScheduleRequestsClient,
+		RoleAssignmentSchedulesClient:         &roleAssignmentSchedulesClient,
+		RoleDefinitionsClient:                 &roleDefinitionsClient,
+		RoleEligibilityScheduleRequestsClient: &roleEligibilityScheduleRequestsClient,
+		RoleEligibilitySchedulesClient:        &roleEligibilitySchedulesClient,
+		RoleManagementPoliciesClient:          &roleManagementPoliciesClient,
+		RoleManagementPolicyAssignmentsClient: &roleManagementPolicyAssignmentsClient,
 	}
 }
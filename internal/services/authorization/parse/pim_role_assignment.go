@@ -0,0 +1,63 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PimRoleAssignmentId is a pseudo ID for a Privileged Identity Management Role Assignment, since the
+// Schedules created by the API are identified by a server-generated name which isn't known up front.
+// It is formed of the Scope, the Role Definition ID and the Principal ID, separated by a pipe.
+type PimRoleAssignmentId struct {
+	Scope            string
+	RoleDefinitionId string
+	PrincipalId      string
+}
+
+func NewPimRoleAssignmentID(scope, roleDefinitionId, principalId string) PimRoleAssignmentId {
+	return PimRoleAssignmentId{
+		Scope:            scope,
+		RoleDefinitionId: roleDefinitionId,
+		PrincipalId:      principalId,
+	}
+}
+
+func (id PimRoleAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s|%s", id.Scope, id.RoleDefinitionId, id.PrincipalId)
+}
+
+func (id PimRoleAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Role Definition ID %q", id.RoleDefinitionId),
+		fmt.Sprintf("Principal ID %q", id.PrincipalId),
+	}
+	return fmt.Sprintf("Privileged Identity Management Role Assignment (%s)", strings.Join(components, " / "))
+}
+
+func PimRoleAssignmentID(input string) (*PimRoleAssignmentId, error) {
+	parts := strings.Split(input, "|")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("expected the Privileged Identity Management Role Assignment ID to be in the format `{scope}|{roleDefinitionId}|{principalId}` but got %q", input)
+	}
+
+	id := PimRoleAssignmentId{
+		Scope:            parts[0],
+		RoleDefinitionId: parts[1],
+		PrincipalId:      parts[2],
+	}
+
+	if !strings.HasPrefix(id.Scope, "/") {
+		return nil, fmt.Errorf("expected the scope in %q to begin with `/`", input)
+	}
+
+	if !strings.Contains(strings.ToLower(id.RoleDefinitionId), "/providers/microsoft.authorization/roledefinitions/") {
+		return nil, fmt.Errorf("expected %q to contain a Role Definition ID", input)
+	}
+
+	if id.PrincipalId == "" {
+		return nil, fmt.Errorf("ID was missing the Principal ID in %q", input)
+	}
+
+	return &id, nil
+}
@@ -0,0 +1,92 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestPimRoleAssignmentIDFormatter(t *testing.T) {
+	actual := NewPimRoleAssignmentID("/subscriptions/12345678-1234-9876-4563-123456789012", "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7", "23456781-2349-8764-5631-234567890121").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|23456781-2349-8764-5631-234567890121"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPimRoleAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *PimRoleAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+		{
+			// missing principal ID
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+			Error: true,
+		},
+		{
+			// empty principal ID
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|",
+			Error: true,
+		},
+		{
+			// scope isn't an ID
+			Input: "subscriptions|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|23456781-2349-8764-5631-234567890121",
+			Error: true,
+		},
+		{
+			// role definition isn't a role definition ID
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|acdd72a7-3385-48ef-bd42-f606fba81ae7|23456781-2349-8764-5631-234567890121",
+			Error: true,
+		},
+		{
+			// subscription scope
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012|/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|23456781-2349-8764-5631-234567890121",
+			Expected: &PimRoleAssignmentId{
+				Scope:            "/subscriptions/12345678-1234-9876-4563-123456789012",
+				RoleDefinitionId: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+				PrincipalId:      "23456781-2349-8764-5631-234567890121",
+			},
+		},
+		{
+			// management group scope
+			Input: "/providers/Microsoft.Management/managementGroups/group1|/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7|23456781-2349-8764-5631-234567890121",
+			Expected: &PimRoleAssignmentId{
+				Scope:            "/providers/Microsoft.Management/managementGroups/group1",
+				RoleDefinitionId: "/providers/Microsoft.Authorization/roleDefinitions/acdd72a7-3385-48ef-bd42-f606fba81ae7",
+				PrincipalId:      "23456781-2349-8764-5631-234567890121",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := PimRoleAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.RoleDefinitionId != v.Expected.RoleDefinitionId {
+			t.Fatalf("Expected %q but got %q for RoleDefinitionId", v.Expected.RoleDefinitionId, actual.RoleDefinitionId)
+		}
+		if actual.PrincipalId != v.Expected.PrincipalId {
+			t.Fatalf("Expected %q but got %q for PrincipalId", v.Expected.PrincipalId, actual.PrincipalId)
+		}
+	}
+}
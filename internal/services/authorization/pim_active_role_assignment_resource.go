@@ -0,0 +1,325 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedulerequests"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PimActiveRoleAssignmentResource struct{}
+
+var _ sdk.Resource = PimActiveRoleAssignmentResource{}
+
+func (r PimActiveRoleAssignmentResource) ResourceType() string {
+	return "azurerm_pim_active_role_assignment"
+}
+
+func (r PimActiveRoleAssignmentResource) ModelObject() interface{} {
+	return &PimRoleAssignmentModel{}
+}
+
+func (r PimActiveRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PimRoleAssignmentID
+}
+
+func (r PimActiveRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentArguments()
+}
+
+func (r PimActiveRoleAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentAttributes()
+}
+
+func (r PimActiveRoleAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model PimRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			requestsClient := metadata.Client.Authorization.RoleAssignmentScheduleRequestsClient
+			schedulesClient := metadata.Client.Authorization.RoleAssignmentSchedulesClient
+
+			id := parse.NewPimRoleAssignmentID(model.Scope, model.RoleDefinitionId, model.PrincipalId)
+			existing, err := findRoleAssignmentSchedule(ctx, schedulesClient, id)
+			if err != nil {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating a name for the Role Assignment Schedule Request: %+v", err)
+			}
+
+			schedule := expandPimSchedule(model.Schedule)
+			expirationType := roleassignmentschedulerequests.Type(schedule.ExpirationType)
+			properties := roleassignmentschedulerequests.RoleAssignmentScheduleRequestProperties{
+				PrincipalId:      id.PrincipalId,
+				RoleDefinitionId: id.RoleDefinitionId,
+				RequestType:      roleassignmentschedulerequests.RequestTypeAdminAssign,
+				ScheduleInfo: &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfo{
+					StartDateTime: utils.String(schedule.StartDateTime),
+					Expiration: &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesScheduleInfoExpiration{
+						Type:        &expirationType,
+						Duration:    schedule.Duration,
+						EndDateTime: schedule.EndDateTime,
+					},
+				},
+			}
+
+			if model.Justification != "" {
+				properties.Justification = utils.String(model.Justification)
+			}
+
+			if len(model.Ticket) > 0 {
+				properties.TicketInfo = &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesTicketInfo{
+					TicketNumber: utils.String(model.Ticket[0].Number),
+					TicketSystem: utils.String(model.Ticket[0].System),
+				}
+			}
+
+			requestId := roleassignmentschedulerequests.NewScopedRoleAssignmentScheduleRequestID(id.Scope, name)
+			input := roleassignmentschedulerequests.RoleAssignmentScheduleRequest{
+				Properties: &properties,
+			}
+			if _, err := requestsClient.Create(ctx, requestId, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", requestId, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{"NotFound"},
+				Target:     []string{"Exists"},
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+				Refresh:    roleAssignmentScheduleStateRefreshFunc(ctx, schedulesClient, id),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be created: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PimActiveRoleAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			requestsClient := metadata.Client.Authorization.RoleAssignmentScheduleRequestsClient
+			schedulesClient := metadata.Client.Authorization.RoleAssignmentSchedulesClient
+
+			id, err := parse.PimRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing PimRoleAssignmentModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			schedule, err := findRoleAssignmentSchedule(ctx, schedulesClient, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if schedule == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := PimRoleAssignmentModel{
+				Scope:            id.Scope,
+				RoleDefinitionId: id.RoleDefinitionId,
+				PrincipalId:      id.PrincipalId,
+				Justification:    existing.Justification,
+				Schedule:         existing.Schedule,
+				Ticket:           existing.Ticket,
+			}
+
+			props := schedule.Properties
+			if props.PrincipalType != nil {
+				state.PrincipalType = string(*props.PrincipalType)
+			}
+
+			// the justification, ticket and expiration are only available from the Request which created the Schedule
+			if props.RoleAssignmentScheduleRequestId != nil {
+				requestId, err := roleassignmentschedulerequests.ParseScopedRoleAssignmentScheduleRequestIDInsensitively(*props.RoleAssignmentScheduleRequestId)
+				if err != nil {
+					return fmt.Errorf("parsing the Role Assignment Schedule Request ID for %s: %+v", *id, err)
+				}
+
+				resp, err := requestsClient.Get(ctx, *requestId)
+				if err != nil && !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("retrieving %s: %+v", *requestId, err)
+				}
+
+				if model := resp.Model; model != nil && model.Properties != nil {
+					requestProps := model.Properties
+					state.Justification = utils.NormalizeNilableString(requestProps.Justification)
+
+					state.Ticket = []PimTicketModel{}
+					if ticket := requestProps.TicketInfo; ticket != nil && (ticket.TicketNumber != nil || ticket.TicketSystem != nil) {
+						state.Ticket = append(state.Ticket, PimTicketModel{
+							Number: utils.NormalizeNilableString(ticket.TicketNumber),
+							System: utils.NormalizeNilableString(ticket.TicketSystem),
+						})
+					}
+
+					if scheduleInfo := requestProps.ScheduleInfo; scheduleInfo != nil {
+						info := pimScheduleInfo{
+							StartDateTime:  utils.NormalizeNilableString(scheduleInfo.StartDateTime),
+							ExpirationType: pimExpirationTypeNoExpiration,
+						}
+						if expiration := scheduleInfo.Expiration; expiration != nil {
+							if expiration.Type != nil {
+								info.ExpirationType = string(*expiration.Type)
+							}
+							info.Duration = expiration.Duration
+							info.EndDateTime = expiration.EndDateTime
+						}
+
+						if state.Schedule, err = flattenPimSchedule(info, existing.Schedule); err != nil {
+							return fmt.Errorf("flattening `schedule`: %+v", err)
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PimActiveRoleAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			requestsClient := metadata.Client.Authorization.RoleAssignmentScheduleRequestsClient
+			schedulesClient := metadata.Client.Authorization.RoleAssignmentSchedulesClient
+
+			id, err := parse.PimRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model PimRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating a name for the Role Assignment Schedule Request: %+v", err)
+			}
+
+			// an active assignment is removed by submitting a request to remove it
+			properties := roleassignmentschedulerequests.RoleAssignmentScheduleRequestProperties{
+				PrincipalId:      id.PrincipalId,
+				RoleDefinitionId: id.RoleDefinitionId,
+				RequestType:      roleassignmentschedulerequests.RequestTypeAdminRemove,
+				Justification:    utils.String("Removed by Terraform"),
+			}
+
+			if len(model.Ticket) > 0 {
+				properties.TicketInfo = &roleassignmentschedulerequests.RoleAssignmentScheduleRequestPropertiesTicketInfo{
+					TicketNumber: utils.String(model.Ticket[0].Number),
+					TicketSystem: utils.String(model.Ticket[0].System),
+				}
+			}
+
+			requestId := roleassignmentschedulerequests.NewScopedRoleAssignmentScheduleRequestID(id.Scope, name)
+			input := roleassignmentschedulerequests.RoleAssignmentScheduleRequest{
+				Properties: &properties,
+			}
+			if _, err := requestsClient.Create(ctx, requestId, input); err != nil {
+				return fmt.Errorf("removing %s: %+v", *id, err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				Pending:    []string{"Exists"},
+				Target:     []string{"NotFound"},
+				MinTimeout: 10 * time.Second,
+				Timeout:    time.Until(deadline),
+				Refresh:    roleAssignmentScheduleStateRefreshFunc(ctx, schedulesClient, *id),
+			}
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be removed: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// findRoleAssignmentSchedule returns the Role Assignment Schedule for the Principal and Role Definition which
+// is assigned directly at the Scope, or nil if one doesn't exist - activations of an eligible assignment are ignored
+func findRoleAssignmentSchedule(ctx context.Context, client *roleassignmentschedules.RoleAssignmentSchedulesClient, id parse.PimRoleAssignmentId) (*roleassignmentschedules.RoleAssignmentSchedule, error) {
+	options := roleassignmentschedules.ListForScopeOperationOptions{
+		Filter: utils.String(fmt.Sprintf("principalId eq '%s'", id.PrincipalId)),
+	}
+	resp, err := client.ListForScopeComplete(ctx, commonids.NewScopeID(id.Scope), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Assignment Schedules for %s: %+v", commonids.NewScopeID(id.Scope), err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.Scope == nil || props.RoleDefinitionId == nil {
+			continue
+		}
+
+		if props.AssignmentType != nil && *props.AssignmentType == roleassignmentschedules.AssignmentTypeActivated {
+			continue
+		}
+
+		if strings.EqualFold(*props.Scope, id.Scope) && strings.EqualFold(*props.RoleDefinitionId, id.RoleDefinitionId) {
+			schedule := item
+			return &schedule, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func roleAssignmentScheduleStateRefreshFunc(ctx context.Context, client *roleassignmentschedules.RoleAssignmentSchedulesClient, id parse.PimRoleAssignmentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		schedule, err := findRoleAssignmentSchedule(ctx, client, id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if schedule == nil {
+			log.Printf("[DEBUG] No Role Assignment Schedule was found for %s", id)
+			return "", "NotFound", nil
+		}
+
+		return *schedule, "Exists", nil
+	}
+}
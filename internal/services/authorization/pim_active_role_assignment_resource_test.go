@@ -0,0 +1,200 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type PimActiveRoleAssignmentResource struct{}
+
+func TestAccPimActiveRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("principal_type").HasValue("ServicePrincipal"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_expirationByDurationDays(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expirationByDurationDays(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("schedule.0.expiration.0.duration_days").HasValue("8"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPimActiveRoleAssignment_expirationByEndDateTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_pim_active_role_assignment", "test")
+	r := PimActiveRoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expirationByEndDateTime(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r PimActiveRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PimRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	options := roleassignmentschedules.ListForScopeOperationOptions{
+		Filter: utils.String(fmt.Sprintf("principalId eq '%s'", id.PrincipalId)),
+	}
+	resp, err := client.Authorization.RoleAssignmentSchedulesClient.ListForScopeComplete(ctx, commonids.NewScopeID(id.Scope), options)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Assignment Schedules for %s: %+v", *id, err)
+	}
+
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && props.RoleDefinitionId != nil && strings.EqualFold(*props.RoleDefinitionId, id.RoleDefinitionId) {
+			return utils.Bool(true), nil
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r PimActiveRoleAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = azurerm_user_assigned_identity.test.principal_id
+}
+`, r.template(data))
+}
+
+func (r PimActiveRoleAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "import" {
+  scope              = azurerm_pim_active_role_assignment.test.scope
+  role_definition_id = azurerm_pim_active_role_assignment.test.role_definition_id
+  principal_id       = azurerm_pim_active_role_assignment.test.principal_id
+}
+`, r.basic(data))
+}
+
+func (r PimActiveRoleAssignmentResource) expirationByDurationDays(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = azurerm_user_assigned_identity.test.principal_id
+  justification      = "Expiration Duration Set"
+
+  schedule {
+    expiration {
+      duration_days = 8
+    }
+  }
+
+  ticket {
+    number = "1"
+    system = "example ticket system"
+  }
+}
+`, r.template(data))
+}
+
+func (r PimActiveRoleAssignmentResource) expirationByEndDateTime(data acceptance.TestData) string {
+	startDateTime := time.Now().UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_pim_active_role_assignment" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+  principal_id       = azurerm_user_assigned_identity.test.principal_id
+  justification      = "Expiration End Date Set"
+
+  schedule {
+    start_date_time = "%s"
+
+    expiration {
+      end_date_time = "%s"
+    }
+  }
+}
+`, r.template(data), startDateTime.Format(time.RFC3339), startDateTime.Add(192*time.Hour).Format(time.RFC3339))
+}
+
+func (PimActiveRoleAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_role_definition" "test" {
+  name = "Monitoring Reader"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pimactive-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
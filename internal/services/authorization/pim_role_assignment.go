@@ -0,0 +1,293 @@
+package authorization
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the Eligible and Active Privileged Identity Management Role Assignments share a schema, but are
+// managed using different (although identically shaped) API's
+
+type PimRoleAssignmentModel struct {
+	Scope            string             `tfschema:"scope"`
+	RoleDefinitionId string             `tfschema:"role_definition_id"`
+	PrincipalId      string             `tfschema:"principal_id"`
+	Justification    string             `tfschema:"justification"`
+	Schedule         []PimScheduleModel `tfschema:"schedule"`
+	Ticket           []PimTicketModel   `tfschema:"ticket"`
+	PrincipalType    string             `tfschema:"principal_type"`
+}
+
+type PimScheduleModel struct {
+	StartDateTime string               `tfschema:"start_date_time"`
+	Expiration    []PimExpirationModel `tfschema:"expiration"`
+}
+
+type PimExpirationModel struct {
+	DurationDays  int64  `tfschema:"duration_days"`
+	DurationHours int64  `tfschema:"duration_hours"`
+	EndDateTime   string `tfschema:"end_date_time"`
+}
+
+type PimTicketModel struct {
+	Number string `tfschema:"number"`
+	System string `tfschema:"system"`
+}
+
+func pimRoleAssignmentArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: roleAssignmentScopeValidateFunc(),
+		},
+
+		"role_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"justification": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"schedule": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"start_date_time": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsRFC3339Time,
+					},
+
+					"expiration": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Computed: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"duration_days": {
+									Type:          pluginsdk.TypeInt,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IntAtLeast(1),
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_hours", "schedule.0.expiration.0.end_date_time"},
+								},
+
+								"duration_hours": {
+									Type:          pluginsdk.TypeInt,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IntAtLeast(1),
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_days", "schedule.0.expiration.0.end_date_time"},
+								},
+
+								"end_date_time": {
+									Type:          pluginsdk.TypeString,
+									Optional:      true,
+									Computed:      true,
+									ForceNew:      true,
+									ValidateFunc:  validation.IsRFC3339Time,
+									ConflictsWith: []string{"schedule.0.expiration.0.duration_days", "schedule.0.expiration.0.duration_hours"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"ticket": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"number": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"system": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+	}
+}
+
+func pimRoleAssignmentAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"principal_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// pimScheduleInfo is the API-agnostic representation of the Schedule for a Role Assignment Request
+type pimScheduleInfo struct {
+	StartDateTime  string
+	ExpirationType string
+	Duration       *string
+	EndDateTime    *string
+}
+
+const (
+	pimExpirationTypeAfterDateTime = "AfterDateTime"
+	pimExpirationTypeAfterDuration = "AfterDuration"
+	pimExpirationTypeNoExpiration  = "NoExpiration"
+)
+
+func expandPimSchedule(input []PimScheduleModel) pimScheduleInfo {
+	output := pimScheduleInfo{
+		// the schedule starts immediately unless otherwise specified
+		StartDateTime:  time.Now().UTC().Format(time.RFC3339),
+		ExpirationType: pimExpirationTypeNoExpiration,
+	}
+
+	if len(input) == 0 {
+		return output
+	}
+
+	schedule := input[0]
+	if schedule.StartDateTime != "" {
+		output.StartDateTime = schedule.StartDateTime
+	}
+
+	if len(schedule.Expiration) > 0 {
+		expiration := schedule.Expiration[0]
+		switch {
+		case expiration.DurationDays > 0:
+			output.ExpirationType = pimExpirationTypeAfterDuration
+			output.Duration = utils.String(fmt.Sprintf("P%dD", expiration.DurationDays))
+		case expiration.DurationHours > 0:
+			output.ExpirationType = pimExpirationTypeAfterDuration
+			output.Duration = utils.String(fmt.Sprintf("PT%dH", expiration.DurationHours))
+		case expiration.EndDateTime != "":
+			output.ExpirationType = pimExpirationTypeAfterDateTime
+			output.EndDateTime = utils.String(expiration.EndDateTime)
+		}
+	}
+
+	return output
+}
+
+func flattenPimSchedule(input pimScheduleInfo, existing []PimScheduleModel) ([]PimScheduleModel, error) {
+	existingStartDateTime := ""
+	existingEndDateTime := ""
+	if len(existing) > 0 {
+		existingStartDateTime = existing[0].StartDateTime
+		if len(existing[0].Expiration) > 0 {
+			existingEndDateTime = existing[0].Expiration[0].EndDateTime
+		}
+	}
+
+	schedule := PimScheduleModel{
+		StartDateTime: normalizePimDateTime(input.StartDateTime, existingStartDateTime),
+		Expiration:    []PimExpirationModel{},
+	}
+
+	switch input.ExpirationType {
+	case pimExpirationTypeAfterDuration:
+		if input.Duration == nil {
+			break
+		}
+		days, hours, err := parsePimDuration(*input.Duration)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Expiration = append(schedule.Expiration, PimExpirationModel{
+			DurationDays:  days,
+			DurationHours: hours,
+		})
+
+	case pimExpirationTypeAfterDateTime:
+		if input.EndDateTime == nil {
+			break
+		}
+		schedule.Expiration = append(schedule.Expiration, PimExpirationModel{
+			EndDateTime: normalizePimDateTime(*input.EndDateTime, existingEndDateTime),
+		})
+	}
+
+	return []PimScheduleModel{schedule}, nil
+}
+
+var pimDurationRegex = regexp.MustCompile(`^P(?:(\d+)D)?(?:T(?:(\d+)H)?)?$`)
+
+// parsePimDuration parses an ISO8601 Duration in days and/or hours (e.g. `P7D` or `PT8H`) as returned by the API
+func parsePimDuration(input string) (days int64, hours int64, err error) {
+	matches := pimDurationRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return 0, 0, fmt.Errorf("parsing the duration %q: expected a duration in days or hours", input)
+	}
+
+	if matches[1] != "" {
+		if days, err = strconv.ParseInt(matches[1], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("parsing the days in the duration %q: %+v", input, err)
+		}
+	}
+
+	if matches[2] != "" {
+		if hours, err = strconv.ParseInt(matches[2], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("parsing the hours in the duration %q: %+v", input, err)
+		}
+	}
+
+	return days, hours, nil
+}
+
+// normalizePimDateTime returns the date/time from the API in RFC3339 format, since the API adds fractional
+// seconds and converts it to UTC - keeping the existing value when it refers to the same point in time
+func normalizePimDateTime(input string, existing string) string {
+	v, err := time.Parse(time.RFC3339, input)
+	if err != nil {
+		return input
+	}
+
+	if e, err := time.Parse(time.RFC3339, existing); err == nil && e.Equal(v) {
+		return existing
+	}
+
+	return v.UTC().Format(time.RFC3339)
+}
@@ -53,23 +53,10 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 			},
 
 			"scope": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.Any(
-					// Elevated access for a global admin is needed to assign roles in this scope:
-					// https://docs.microsoft.com/en-us/azure/role-based-access-control/elevate-access-global-admin#azure-cli
-					// It seems only user account is allowed to be elevated access.
-					validation.StringInSlice([]string{
-						"/providers/Microsoft.Subscription",
-					}, false),
-
-					billingValidate.EnrollmentID,
-					commonids.ValidateManagementGroupID,
-					commonids.ValidateSubscriptionID,
-					commonids.ValidateResourceGroupID,
-					azure.ValidateResourceID,
-				),
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: roleAssignmentScopeValidateFunc(),
 			},
 
 			"role_definition_id": {
@@ -187,6 +174,11 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	tenantId := ""
 	delegatedManagedIdentityResourceID := d.Get("delegated_managed_identity_resource_id").(string)
 	if len(delegatedManagedIdentityResourceID) > 0 {
+		// the Role Assignment can be scoped to a Subscription other than the one the Provider is configured for
+		if scopeSubscriptionId := subscriptionIdFromScope(scope); scopeSubscriptionId != "" {
+			subscriptionId = scopeSubscriptionId
+		}
+
 		var err error
 		tenantId, err = getTenantIdBySubscriptionId(ctx, subscriptionClient, subscriptionId)
 		if err != nil {
@@ -411,3 +403,29 @@ func getTenantIdBySubscriptionId(ctx context.Context, client *subscriptions.Clie
 	}
 	return *resp.TenantID, nil
 }
+
+// roleAssignmentScopeValidateFunc validates the ARM scopes to which a Role can be assigned
+func roleAssignmentScopeValidateFunc() pluginsdk.SchemaValidateFunc {
+	return validation.Any(
+		// Elevated access for a global admin is needed to assign roles in this scope:
+		// https://docs.microsoft.com/en-us/azure/role-based-access-control/elevate-access-global-admin#azure-cli
+		// It seems only user account is allowed to be elevated access.
+		validation.StringInSlice([]string{
+			"/providers/Microsoft.Subscription",
+		}, false),
+
+		billingValidate.EnrollmentID,
+		commonids.ValidateManagementGroupID,
+		commonids.ValidateSubscriptionID,
+		commonids.ValidateResourceGroupID,
+		azure.ValidateResourceID,
+	)
+}
+
+func subscriptionIdFromScope(scope string) string {
+	segments := strings.Split(strings.TrimPrefix(scope, "/"), "/")
+	if len(segments) >= 2 && strings.EqualFold(segments[0], "subscriptions") {
+		return segments[1]
+	}
+	return ""
+}
@@ -0,0 +1,769 @@
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicyassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RoleManagementPolicyResource struct{}
+
+var _ sdk.ResourceWithUpdate = RoleManagementPolicyResource{}
+
+type RoleManagementPolicyModel struct {
+	Scope                   string                                   `tfschema:"scope"`
+	RoleDefinitionId        string                                   `tfschema:"role_definition_id"`
+	ActivationRules         []RoleManagementPolicyActivationRules    `tfschema:"activation_rules"`
+	ActiveAssignmentRules   []RoleManagementPolicyActiveAssignment   `tfschema:"active_assignment_rules"`
+	EligibleAssignmentRules []RoleManagementPolicyEligibleAssignment `tfschema:"eligible_assignment_rules"`
+	NotificationRules       []RoleManagementPolicyNotificationRule   `tfschema:"notification_rule"`
+	Name                    string                                   `tfschema:"name"`
+	Description             string                                   `tfschema:"description"`
+}
+
+type RoleManagementPolicyActivationRules struct {
+	MaximumDuration                  string                         `tfschema:"maximum_duration"`
+	RequireApproval                  bool                           `tfschema:"require_approval"`
+	Approvers                        []RoleManagementPolicyApprover `tfschema:"approver"`
+	RequireMultiFactorAuthentication bool                           `tfschema:"require_multifactor_authentication"`
+	RequireJustification             bool                           `tfschema:"require_justification"`
+	RequireTicketInfo                bool                           `tfschema:"require_ticket_info"`
+}
+
+type RoleManagementPolicyApprover struct {
+	ObjectId string `tfschema:"object_id"`
+	Type     string `tfschema:"type"`
+}
+
+type RoleManagementPolicyActiveAssignment struct {
+	ExpirationRequired               bool   `tfschema:"expiration_required"`
+	ExpireAfter                      string `tfschema:"expire_after"`
+	RequireMultiFactorAuthentication bool   `tfschema:"require_multifactor_authentication"`
+	RequireJustification             bool   `tfschema:"require_justification"`
+}
+
+type RoleManagementPolicyEligibleAssignment struct {
+	ExpirationRequired bool   `tfschema:"expiration_required"`
+	ExpireAfter        string `tfschema:"expire_after"`
+}
+
+type RoleManagementPolicyNotificationRule struct {
+	Id                   string   `tfschema:"id"`
+	NotificationLevel    string   `tfschema:"notification_level"`
+	DefaultRecipients    bool     `tfschema:"default_recipients"`
+	AdditionalRecipients []string `tfschema:"additional_recipients"`
+}
+
+// the ID's of the Rules within a Role Management Policy which are exposed by this resource
+const (
+	roleManagementPolicyRuleApprovalEndUserAssignment   = "Approval_EndUser_Assignment"
+	roleManagementPolicyRuleEnablementAdminAssignment   = "Enablement_Admin_Assignment"
+	roleManagementPolicyRuleEnablementEndUserAssignment = "Enablement_EndUser_Assignment"
+	roleManagementPolicyRuleExpirationAdminAssignment   = "Expiration_Admin_Assignment"
+	roleManagementPolicyRuleExpirationAdminEligibility  = "Expiration_Admin_Eligibility"
+	roleManagementPolicyRuleExpirationEndUserAssignment = "Expiration_EndUser_Assignment"
+)
+
+// the requirements which can be enabled within an Enablement Rule
+const (
+	roleManagementPolicyEnabledRuleJustification   = "Justification"
+	roleManagementPolicyEnabledRuleMultiFactorAuth = "MultiFactorAuthentication"
+	roleManagementPolicyEnabledRuleTicketing       = "Ticketing"
+)
+
+func roleManagementPolicyNotificationRuleIds() []string {
+	return []string{
+		"Notification_Admin_Admin_Assignment",
+		"Notification_Admin_Admin_Eligibility",
+		"Notification_Admin_EndUser_Assignment",
+		"Notification_Approver_Admin_Assignment",
+		"Notification_Approver_Admin_Eligibility",
+		"Notification_Approver_EndUser_Assignment",
+		"Notification_Requestor_Admin_Assignment",
+		"Notification_Requestor_Admin_Eligibility",
+		"Notification_Requestor_EndUser_Assignment",
+	}
+}
+
+func (r RoleManagementPolicyResource) ResourceType() string {
+	return "azurerm_role_management_policy"
+}
+
+func (r RoleManagementPolicyResource) ModelObject() interface{} {
+	return &RoleManagementPolicyModel{}
+}
+
+func (r RoleManagementPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return rolemanagementpolicies.ValidateScopedRoleManagementPolicyID
+}
+
+func (r RoleManagementPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: roleAssignmentScopeValidateFunc(),
+		},
+
+		"role_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"activation_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"maximum_duration": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"require_approval": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"approver": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"object_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.IsUUID,
+								},
+
+								"type": {
+									Type:     pluginsdk.TypeString,
+									Required: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"Group",
+										"User",
+									}, false),
+								},
+							},
+						},
+					},
+
+					"require_multifactor_authentication": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"require_justification": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"require_ticket_info": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"active_assignment_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expiration_required": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"expire_after": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"require_multifactor_authentication": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"require_justification": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"eligible_assignment_rules": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expiration_required": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Computed: true,
+					},
+
+					"expire_after": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"notification_rule": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(roleManagementPolicyNotificationRuleIds(), false),
+					},
+
+					"notification_level": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							"All",
+							"Critical",
+							"None",
+						}, false),
+					},
+
+					"default_recipients": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"additional_recipients": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model RoleManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Authorization.RoleManagementPoliciesClient
+			assignmentsClient := metadata.Client.Authorization.RoleManagementPolicyAssignmentsClient
+
+			// a Role Management Policy exists for every Role at every Scope, so rather than being created the
+			// Policy which applies to this Role Definition at this Scope is looked up and then updated
+			id, err := findRoleManagementPolicyId(ctx, assignmentsClient, model.Scope, model.RoleDefinitionId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if err := updateRoleManagementPolicy(ctx, client, *id, existing.Model, model); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleManagementPoliciesClient
+
+			id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model RoleManagementPolicyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			return updateRoleManagementPolicy(ctx, client, *id, existing.Model, model)
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleManagementPoliciesClient
+			assignmentsClient := metadata.Client.Authorization.RoleManagementPolicyAssignmentsClient
+
+			id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existing RoleManagementPolicyModel
+			if err := metadata.Decode(&existing); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := RoleManagementPolicyModel{
+				Scope:            id.Scope,
+				RoleDefinitionId: existing.RoleDefinitionId,
+				Name:             id.RoleManagementPolicyName,
+			}
+
+			if state.RoleDefinitionId == "" {
+				// e.g. when importing, the Role Definition is only available from the Policy Assignment
+				if state.RoleDefinitionId, err = findRoleManagementPolicyRoleDefinitionId(ctx, assignmentsClient, *id); err != nil {
+					return err
+				}
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties
+				state.Description = utils.NormalizeNilableString(props.Description)
+				if props.Scope != nil {
+					state.Scope = *props.Scope
+				}
+
+				rules := make(map[string]azuresdkhacks.RoleManagementPolicyRule)
+				if props.Rules != nil {
+					for _, rule := range *props.Rules {
+						if ruleId, ok := rule["id"].(string); ok {
+							rules[ruleId] = rule
+						}
+					}
+				}
+
+				state.ActivationRules = flattenRoleManagementPolicyActivationRules(rules)
+				state.ActiveAssignmentRules = flattenRoleManagementPolicyActiveAssignmentRules(rules)
+				state.EligibleAssignmentRules = flattenRoleManagementPolicyEligibleAssignmentRules(rules)
+
+				// there are a number of Notification Rules, so only those which are configured are tracked
+				state.NotificationRules = make([]RoleManagementPolicyNotificationRule, 0)
+				for _, v := range existing.NotificationRules {
+					if rule, ok := rules[v.Id]; ok {
+						state.NotificationRules = append(state.NotificationRules, flattenRoleManagementPolicyNotificationRule(v.Id, rule))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RoleManagementPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Role Management Policies can't be deleted, they exist for as long as the Scope does
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+func findRoleManagementPolicyId(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, scope string, roleDefinitionId string) (*rolemanagementpolicies.ScopedRoleManagementPolicyId, error) {
+	scopeId := commonids.NewScopeID(scope)
+	resp, err := client.ListForScopeComplete(ctx, scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Role Management Policy Assignments for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.RoleDefinitionId == nil || props.PolicyId == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.RoleDefinitionId, roleDefinitionId) {
+			id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyIDInsensitively(*props.PolicyId)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the Role Management Policy ID %q: %+v", *props.PolicyId, err)
+			}
+			return id, nil
+		}
+	}
+
+	return nil, fmt.Errorf("a Role Management Policy for the Role Definition %q was not found at %s", roleDefinitionId, scopeId)
+}
+
+func findRoleManagementPolicyRoleDefinitionId(ctx context.Context, client *rolemanagementpolicyassignments.RoleManagementPolicyAssignmentsClient, id rolemanagementpolicies.ScopedRoleManagementPolicyId) (string, error) {
+	scopeId := commonids.NewScopeID(id.Scope)
+	resp, err := client.ListForScopeComplete(ctx, scopeId)
+	if err != nil {
+		return "", fmt.Errorf("listing Role Management Policy Assignments for %s: %+v", scopeId, err)
+	}
+
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || props.RoleDefinitionId == nil || props.PolicyId == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.PolicyId, id.ID()) {
+			return *props.RoleDefinitionId, nil
+		}
+	}
+
+	return "", fmt.Errorf("a Role Management Policy Assignment for %s was not found", id)
+}
+
+func updateRoleManagementPolicy(ctx context.Context, client *azuresdkhacks.RoleManagementPoliciesWorkaroundClient, id rolemanagementpolicies.ScopedRoleManagementPolicyId, existing *azuresdkhacks.RoleManagementPolicy, model RoleManagementPolicyModel) error {
+	if existing == nil || existing.Properties == nil || existing.Properties.Rules == nil {
+		return fmt.Errorf("retrieving %s: `properties.rules` was nil", id)
+	}
+
+	rules := make(map[string]azuresdkhacks.RoleManagementPolicyRule)
+	for _, rule := range *existing.Properties.Rules {
+		if ruleId, ok := rule["id"].(string); ok {
+			rules[ruleId] = rule
+		}
+	}
+
+	// only the Rules which are changed are sent, since the remaining Rules are left as-is by the API
+	updatedRules := make(map[string]azuresdkhacks.RoleManagementPolicyRule)
+	getRule := func(ruleId string) (azuresdkhacks.RoleManagementPolicyRule, error) {
+		rule, ok := rules[ruleId]
+		if !ok {
+			return nil, fmt.Errorf("the rule %q was not found in %s", ruleId, id)
+		}
+		updatedRules[ruleId] = rule
+		return rule, nil
+	}
+
+	if len(model.ActivationRules) > 0 {
+		activation := model.ActivationRules[0]
+
+		rule, err := getRule(roleManagementPolicyRuleExpirationEndUserAssignment)
+		if err != nil {
+			return err
+		}
+		if activation.MaximumDuration != "" {
+			rule["maximumDuration"] = activation.MaximumDuration
+		}
+
+		rule, err = getRule(roleManagementPolicyRuleEnablementEndUserAssignment)
+		if err != nil {
+			return err
+		}
+		enabledRules := make([]interface{}, 0)
+		if activation.RequireMultiFactorAuthentication {
+			enabledRules = append(enabledRules, roleManagementPolicyEnabledRuleMultiFactorAuth)
+		}
+		if activation.RequireJustification {
+			enabledRules = append(enabledRules, roleManagementPolicyEnabledRuleJustification)
+		}
+		if activation.RequireTicketInfo {
+			enabledRules = append(enabledRules, roleManagementPolicyEnabledRuleTicketing)
+		}
+		rule["enabledRules"] = enabledRules
+
+		rule, err = getRule(roleManagementPolicyRuleApprovalEndUserAssignment)
+		if err != nil {
+			return err
+		}
+		expandRoleManagementPolicyApprovalRule(rule, activation)
+	}
+
+	if len(model.ActiveAssignmentRules) > 0 {
+		active := model.ActiveAssignmentRules[0]
+
+		rule, err := getRule(roleManagementPolicyRuleExpirationAdminAssignment)
+		if err != nil {
+			return err
+		}
+		rule["isExpirationRequired"] = active.ExpirationRequired
+		if active.ExpireAfter != "" {
+			rule["maximumDuration"] = active.ExpireAfter
+		}
+
+		rule, err = getRule(roleManagementPolicyRuleEnablementAdminAssignment)
+		if err != nil {
+			return err
+		}
+		enabledRules := make([]interface{}, 0)
+		if active.RequireMultiFactorAuthentication {
+			enabledRules = append(enabledRules, roleManagementPolicyEnabledRuleMultiFactorAuth)
+		}
+		if active.RequireJustification {
+			enabledRules = append(enabledRules, roleManagementPolicyEnabledRuleJustification)
+		}
+		rule["enabledRules"] = enabledRules
+	}
+
+	if len(model.EligibleAssignmentRules) > 0 {
+		eligible := model.EligibleAssignmentRules[0]
+
+		rule, err := getRule(roleManagementPolicyRuleExpirationAdminEligibility)
+		if err != nil {
+			return err
+		}
+		rule["isExpirationRequired"] = eligible.ExpirationRequired
+		if eligible.ExpireAfter != "" {
+			rule["maximumDuration"] = eligible.ExpireAfter
+		}
+	}
+
+	for _, notification := range model.NotificationRules {
+		rule, err := getRule(notification.Id)
+		if err != nil {
+			return err
+		}
+
+		recipients := make([]interface{}, 0)
+		for _, v := range notification.AdditionalRecipients {
+			recipients = append(recipients, v)
+		}
+
+		rule["notificationLevel"] = notification.NotificationLevel
+		rule["isDefaultRecipientsEnabled"] = notification.DefaultRecipients
+		rule["notificationRecipients"] = recipients
+	}
+
+	if len(updatedRules) == 0 {
+		return nil
+	}
+
+	input := azuresdkhacks.RoleManagementPolicy{
+		Properties: &azuresdkhacks.RoleManagementPolicyProperties{
+			Rules: &[]azuresdkhacks.RoleManagementPolicyRule{},
+		},
+	}
+	for _, rule := range updatedRules {
+		*input.Properties.Rules = append(*input.Properties.Rules, rule)
+	}
+
+	if _, err := client.Update(ctx, id, input); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandRoleManagementPolicyApprovalRule(rule azuresdkhacks.RoleManagementPolicyRule, input RoleManagementPolicyActivationRules) {
+	setting, ok := rule["setting"].(map[string]interface{})
+	if !ok {
+		setting = make(map[string]interface{})
+	}
+	setting["isApprovalRequired"] = input.RequireApproval
+
+	approvers := make([]interface{}, 0)
+	for _, v := range input.Approvers {
+		approvers = append(approvers, map[string]interface{}{
+			"id":       v.ObjectId,
+			"userType": v.Type,
+			"isBackup": false,
+		})
+	}
+
+	// the approvers are configured within the first (and only supported) Approval Stage
+	stage := map[string]interface{}{
+		"approvalStageTimeOutInDays":      1,
+		"isApproverJustificationRequired": true,
+		"escalationTimeInMinutes":         0,
+		"isEscalationEnabled":             false,
+	}
+	if stages, ok := setting["approvalStages"].([]interface{}); ok && len(stages) > 0 {
+		if v, ok := stages[0].(map[string]interface{}); ok {
+			stage = v
+		}
+	}
+	stage["primaryApprovers"] = approvers
+	setting["approvalStages"] = []interface{}{stage}
+
+	rule["setting"] = setting
+}
+
+func flattenRoleManagementPolicyActivationRules(rules map[string]azuresdkhacks.RoleManagementPolicyRule) []RoleManagementPolicyActivationRules {
+	output := RoleManagementPolicyActivationRules{
+		Approvers: []RoleManagementPolicyApprover{},
+	}
+
+	if rule, ok := rules[roleManagementPolicyRuleExpirationEndUserAssignment]; ok {
+		output.MaximumDuration, _ = rule["maximumDuration"].(string)
+	}
+
+	if rule, ok := rules[roleManagementPolicyRuleEnablementEndUserAssignment]; ok {
+		enabledRules := flattenRoleManagementPolicyEnabledRules(rule)
+		output.RequireMultiFactorAuthentication = utils.SliceContainsValue(enabledRules, roleManagementPolicyEnabledRuleMultiFactorAuth)
+		output.RequireJustification = utils.SliceContainsValue(enabledRules, roleManagementPolicyEnabledRuleJustification)
+		output.RequireTicketInfo = utils.SliceContainsValue(enabledRules, roleManagementPolicyEnabledRuleTicketing)
+	}
+
+	if rule, ok := rules[roleManagementPolicyRuleApprovalEndUserAssignment]; ok {
+		if setting, ok := rule["setting"].(map[string]interface{}); ok {
+			output.RequireApproval, _ = setting["isApprovalRequired"].(bool)
+
+			if stages, ok := setting["approvalStages"].([]interface{}); ok && len(stages) > 0 {
+				if stage, ok := stages[0].(map[string]interface{}); ok {
+					if approvers, ok := stage["primaryApprovers"].([]interface{}); ok {
+						for _, v := range approvers {
+							approver, ok := v.(map[string]interface{})
+							if !ok {
+								continue
+							}
+
+							objectId, _ := approver["id"].(string)
+							userType, _ := approver["userType"].(string)
+							output.Approvers = append(output.Approvers, RoleManagementPolicyApprover{
+								ObjectId: objectId,
+								Type:     userType,
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return []RoleManagementPolicyActivationRules{output}
+}
+
+func flattenRoleManagementPolicyActiveAssignmentRules(rules map[string]azuresdkhacks.RoleManagementPolicyRule) []RoleManagementPolicyActiveAssignment {
+	output := RoleManagementPolicyActiveAssignment{}
+
+	if rule, ok := rules[roleManagementPolicyRuleExpirationAdminAssignment]; ok {
+		output.ExpirationRequired, _ = rule["isExpirationRequired"].(bool)
+		output.ExpireAfter, _ = rule["maximumDuration"].(string)
+	}
+
+	if rule, ok := rules[roleManagementPolicyRuleEnablementAdminAssignment]; ok {
+		enabledRules := flattenRoleManagementPolicyEnabledRules(rule)
+		output.RequireMultiFactorAuthentication = utils.SliceContainsValue(enabledRules, roleManagementPolicyEnabledRuleMultiFactorAuth)
+		output.RequireJustification = utils.SliceContainsValue(enabledRules, roleManagementPolicyEnabledRuleJustification)
+	}
+
+	return []RoleManagementPolicyActiveAssignment{output}
+}
+
+func flattenRoleManagementPolicyEligibleAssignmentRules(rules map[string]azuresdkhacks.RoleManagementPolicyRule) []RoleManagementPolicyEligibleAssignment {
+	output := RoleManagementPolicyEligibleAssignment{}
+
+	if rule, ok := rules[roleManagementPolicyRuleExpirationAdminEligibility]; ok {
+		output.ExpirationRequired, _ = rule["isExpirationRequired"].(bool)
+		output.ExpireAfter, _ = rule["maximumDuration"].(string)
+	}
+
+	return []RoleManagementPolicyEligibleAssignment{output}
+}
+
+func flattenRoleManagementPolicyNotificationRule(ruleId string, rule azuresdkhacks.RoleManagementPolicyRule) RoleManagementPolicyNotificationRule {
+	output := RoleManagementPolicyNotificationRule{
+		Id:                   ruleId,
+		AdditionalRecipients: []string{},
+	}
+
+	output.NotificationLevel, _ = rule["notificationLevel"].(string)
+	output.DefaultRecipients, _ = rule["isDefaultRecipientsEnabled"].(bool)
+	if recipients, ok := rule["notificationRecipients"].([]interface{}); ok {
+		for _, v := range recipients {
+			if recipient, ok := v.(string); ok {
+				output.AdditionalRecipients = append(output.AdditionalRecipients, recipient)
+			}
+		}
+	}
+
+	return output
+}
+
+func flattenRoleManagementPolicyEnabledRules(rule azuresdkhacks.RoleManagementPolicyRule) []string {
+	output := make([]string, 0)
+	if enabledRules, ok := rule["enabledRules"].([]interface{}); ok {
+		for _, v := range enabledRules {
+			if enabledRule, ok := v.(string); ok {
+				output = append(output, enabledRule)
+			}
+		}
+	}
+	return output
+}
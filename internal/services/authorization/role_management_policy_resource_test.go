@@ -0,0 +1,185 @@
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/rolemanagementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RoleManagementPolicyResource struct{}
+
+func TestAccRoleManagementPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").Exists(),
+				check.That(data.ResourceName).Key("activation_rules.0.maximum_duration").HasValue("PT1H"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRoleManagementPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("activation_rules.0.require_approval").HasValue("true"),
+				check.That(data.ResourceName).Key("activation_rules.0.approver.#").HasValue("1"),
+			),
+		},
+		data.ImportStep("notification_rule"),
+	})
+}
+
+func TestAccRoleManagementPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_management_policy", "test")
+	r := RoleManagementPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("notification_rule"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RoleManagementPolicyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := rolemanagementpolicies.ParseScopedRoleManagementPolicyID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Authorization.RoleManagementPoliciesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r RoleManagementPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_management_policy" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+
+  activation_rules {
+    maximum_duration                   = "PT1H"
+    require_approval                   = false
+    require_multifactor_authentication = false
+    require_justification              = true
+    require_ticket_info                = false
+  }
+}
+`, r.template(data))
+}
+
+func (r RoleManagementPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+provider "azuread" {}
+
+resource "azuread_group" "approver" {
+  display_name     = "acctestgroup-approver-%d"
+  security_enabled = true
+}
+
+resource "azurerm_role_management_policy" "test" {
+  scope              = azurerm_resource_group.test.id
+  role_definition_id = "${data.azurerm_subscription.primary.id}${data.azurerm_role_definition.test.id}"
+
+  activation_rules {
+    maximum_duration                   = "PT4H"
+    require_approval                   = true
+    require_multifactor_authentication = true
+    require_justification              = true
+    require_ticket_info                = true
+
+    approver {
+      object_id = azuread_group.approver.object_id
+      type      = "Group"
+    }
+  }
+
+  active_assignment_rules {
+    expiration_required                = true
+    expire_after                       = "P90D"
+    require_multifactor_authentication = false
+    require_justification              = true
+  }
+
+  eligible_assignment_rules {
+    expiration_required = true
+    expire_after        = "P180D"
+  }
+
+  notification_rule {
+    id                    = "Notification_Admin_Admin_Eligibility"
+    notification_level    = "Critical"
+    default_recipients    = false
+    additional_recipients = ["someone@example.com"]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (RoleManagementPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {}
+
+data "azurerm_role_definition" "test" {
+  name = "Monitoring Reader"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-rmp-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
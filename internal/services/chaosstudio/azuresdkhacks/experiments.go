@@ -0,0 +1,152 @@
+package azuresdkhacks
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/experiments"
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+)
+
+type Experiment struct {
+	Id         *string                  `json:"id,omitempty"`
+	Identity   *identity.SystemAssigned `json:"identity,omitempty"`
+	Location   string                   `json:"location"`
+	Name       *string                  `json:"name,omitempty"`
+	Properties ExperimentProperties     `json:"properties"`
+	SystemData *systemdata.SystemData   `json:"systemData,omitempty"`
+	Tags       *map[string]string       `json:"tags,omitempty"`
+	Type       *string                  `json:"type,omitempty"`
+}
+
+type ExperimentProperties struct {
+	Selectors       []experiments.Selector `json:"selectors"`
+	StartOnCreation *bool                  `json:"startOnCreation,omitempty"`
+	Steps           []Step                 `json:"steps"`
+}
+
+type Step struct {
+	Branches []Branch `json:"branches"`
+	Name     string   `json:"name"`
+}
+
+type Branch struct {
+	Actions []Action `json:"actions"`
+	Name    string   `json:"name"`
+}
+
+// Action is a workaround for the vendored SDK only modelling the `name` and `type` of an Action, which drops
+// the `duration`, `parameters` and `selectorId` fields of the `continuous`, `delay` and `discrete` actions
+type Action struct {
+	Duration   *string         `json:"duration,omitempty"`
+	Name       string          `json:"name"`
+	Parameters *[]KeyValuePair `json:"parameters,omitempty"`
+	SelectorId *string         `json:"selectorId,omitempty"`
+	Type       string          `json:"type"`
+}
+
+type KeyValuePair struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type ExperimentsClient struct {
+	client *experiments.ExperimentsClient
+}
+
+func NewExperimentsClient(client *experiments.ExperimentsClient) ExperimentsClient {
+	return ExperimentsClient{
+		client: client,
+	}
+}
+
+type CreateOrUpdateOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Experiment
+}
+
+// CreateOrUpdate is a workaround to send the full Action models, and to handle that the Azure API can return
+// either a 200 / 201 rather than the 200 documented in the Swagger
+func (c ExperimentsClient) CreateOrUpdate(ctx context.Context, id experiments.ExperimentId, input Experiment) (result CreateOrUpdateOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type GetOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *Experiment
+}
+
+// Get is a workaround to retrieve the full Action models
+func (c ExperimentsClient) Get(ctx context.Context, id experiments.ExperimentId) (result GetOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.client.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+func (c ExperimentsClient) Delete(ctx context.Context, id experiments.ExperimentId) (experiments.DeleteOperationResponse, error) {
+	return c.client.Delete(ctx, id)
+}
@@ -0,0 +1,158 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/capabilities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioCapabilityModel struct {
+	ChaosStudioTargetId string `tfschema:"chaos_studio_target_id"`
+	CapabilityType      string `tfschema:"capability_type"`
+	Urn                 string `tfschema:"urn"`
+}
+
+type ChaosStudioCapabilityResource struct{}
+
+var _ sdk.Resource = ChaosStudioCapabilityResource{}
+
+func (r ChaosStudioCapabilityResource) ResourceType() string {
+	return "azurerm_chaos_studio_capability"
+}
+
+func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
+	return &ChaosStudioCapabilityModel{}
+}
+
+func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capabilities.ValidateCapabilityID
+}
+
+func (r ChaosStudioCapabilityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"chaos_studio_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: capabilities.ValidateTargetID,
+		},
+
+		// the Capability Types available for a Target Type can be listed through the
+		// `Microsoft.Chaos/locations/targetTypes/capabilityTypes` API, e.g. `Shutdown-1.0` or `NetworkChaos-2.0`
+		"capability_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"urn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			var model ChaosStudioCapabilityModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			targetId, err := capabilities.ParseTargetID(model.ChaosStudioTargetId)
+			if err != nil {
+				return err
+			}
+
+			id := capabilities.NewCapabilityID(targetId.SubscriptionId, targetId.ResourceGroupName, targetId.ProviderName, targetId.ParentResourceType, targetId.ParentResourceName, targetId.TargetName, model.CapabilityType)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, capabilities.Capability{}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioCapabilityModel{
+				ChaosStudioTargetId: capabilities.NewTargetID(id.SubscriptionId, id.ResourceGroupName, id.ProviderName, id.ParentResourceType, id.ParentResourceName, id.TargetName).ID(),
+				CapabilityType:      id.CapabilityName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Urn = pointer.From(props.Urn)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,562 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/experiments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/azuresdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	chaosStudioActionTypeContinuous = "continuous"
+	chaosStudioActionTypeDelay      = "delay"
+	chaosStudioActionTypeDiscrete   = "discrete"
+
+	// chaosStudioTimedDelayUrn is the capability used by every `delay` action
+	chaosStudioTimedDelayUrn = "urn:csci:microsoft:chaosStudio:TimedDelay/1.0"
+)
+
+type ChaosStudioExperimentModel struct {
+	Name              string                               `tfschema:"name"`
+	ResourceGroupName string                               `tfschema:"resource_group_name"`
+	Location          string                               `tfschema:"location"`
+	Identity          []identity.ModelSystemAssigned       `tfschema:"identity"`
+	Selectors         []ChaosStudioExperimentSelectorModel `tfschema:"selectors"`
+	Steps             []ChaosStudioExperimentStepModel     `tfschema:"steps"`
+	Tags              map[string]string                    `tfschema:"tags"`
+}
+
+type ChaosStudioExperimentSelectorModel struct {
+	Name                 string   `tfschema:"name"`
+	ChaosStudioTargetIds []string `tfschema:"chaos_studio_target_ids"`
+}
+
+type ChaosStudioExperimentStepModel struct {
+	Name   string                             `tfschema:"name"`
+	Branch []ChaosStudioExperimentBranchModel `tfschema:"branch"`
+}
+
+type ChaosStudioExperimentBranchModel struct {
+	Name    string                             `tfschema:"name"`
+	Actions []ChaosStudioExperimentActionModel `tfschema:"actions"`
+}
+
+type ChaosStudioExperimentActionModel struct {
+	ActionType   string            `tfschema:"action_type"`
+	Urn          string            `tfschema:"urn"`
+	Duration     string            `tfschema:"duration"`
+	Parameters   map[string]string `tfschema:"parameters"`
+	SelectorName string            `tfschema:"selector_name"`
+}
+
+type ChaosStudioExperimentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+
+func (r ChaosStudioExperimentResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment"
+}
+
+func (r ChaosStudioExperimentResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentModel{}
+}
+
+func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExperimentID
+}
+
+func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[^<>%&:?#/\\]+$`),
+				"`name` must not contain the characters `<`, `>`, `%`, `&`, `:`, `?`, `#`, `/` or `\\`",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedIdentityOptional(),
+
+		"selectors": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"chaos_studio_target_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: targets.ValidateTargetID,
+						},
+					},
+				},
+			},
+		},
+
+		"steps": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"actions": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													chaosStudioActionTypeContinuous,
+													chaosStudioActionTypeDelay,
+													chaosStudioActionTypeDiscrete,
+												}, false),
+											},
+
+											"urn": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												Computed:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"duration": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validate.ISO8601Duration,
+											},
+
+											"parameters": {
+												Type:     pluginsdk.TypeMap,
+												Optional: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+												},
+											},
+
+											"selector_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ChaosStudioExperimentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewExperimentsClient(metadata.Client.ChaosStudio.ExperimentsClient)
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model ChaosStudioExperimentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := experiments.NewExperimentID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters, err := expandChaosStudioExperiment(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, *parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewExperimentsClient(metadata.Client.ChaosStudio.ExperimentsClient)
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioExperimentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the API replaces the whole Experiment, so the payload is always built from the full configuration
+			parameters, err := expandChaosStudioExperiment(model)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewExperimentsClient(metadata.Client.ChaosStudio.ExperimentsClient)
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioExperimentModel{
+				Name:              id.ExperimentName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				state.Tags = pointer.From(model.Tags)
+
+				selectors, err := flattenChaosStudioExperimentSelectors(model.Properties.Selectors)
+				if err != nil {
+					return err
+				}
+				state.Selectors = selectors
+
+				steps, err := flattenChaosStudioExperimentSteps(model.Properties.Steps)
+				if err != nil {
+					return err
+				}
+				state.Steps = steps
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioExperimentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := azuresdkhacks.NewExperimentsClient(metadata.Client.ChaosStudio.ExperimentsClient)
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandChaosStudioExperiment(input ChaosStudioExperimentModel) (*azuresdkhacks.Experiment, error) {
+	expandedIdentity, err := identity.ExpandSystemAssignedFromModel(input.Identity)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `identity`: %+v", err)
+	}
+
+	selectorNames := make(map[string]struct{})
+	selectors := make([]experiments.Selector, 0)
+	for _, selector := range input.Selectors {
+		if _, ok := selectorNames[selector.Name]; ok {
+			return nil, fmt.Errorf("the selector name %q is used more than once within `selectors`", selector.Name)
+		}
+		selectorNames[selector.Name] = struct{}{}
+
+		targetReferences := make([]experiments.TargetReference, 0)
+		for _, targetId := range selector.ChaosStudioTargetIds {
+			targetReferences = append(targetReferences, experiments.TargetReference{
+				Id:   targetId,
+				Type: experiments.TargetReferenceTypeChaosTarget,
+			})
+		}
+
+		selectors = append(selectors, experiments.Selector{
+			Id:      selector.Name,
+			Type:    experiments.SelectorTypeList,
+			Targets: targetReferences,
+		})
+	}
+
+	steps := make([]azuresdkhacks.Step, 0)
+	for _, step := range input.Steps {
+		branches := make([]azuresdkhacks.Branch, 0)
+		for _, branch := range step.Branch {
+			actions := make([]azuresdkhacks.Action, 0)
+			for _, action := range branch.Actions {
+				expanded, err := expandChaosStudioExperimentAction(action, selectorNames)
+				if err != nil {
+					return nil, fmt.Errorf("expanding the actions of branch %q in step %q: %+v", branch.Name, step.Name, err)
+				}
+				actions = append(actions, expanded)
+			}
+
+			branches = append(branches, azuresdkhacks.Branch{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		steps = append(steps, azuresdkhacks.Step{
+			Name:     step.Name,
+			Branches: branches,
+		})
+	}
+
+	output := azuresdkhacks.Experiment{
+		Identity: expandedIdentity,
+		Location: location.Normalize(input.Location),
+		Properties: azuresdkhacks.ExperimentProperties{
+			Selectors:       selectors,
+			StartOnCreation: pointer.To(false),
+			Steps:           steps,
+		},
+		Tags: pointer.To(input.Tags),
+	}
+
+	return &output, nil
+}
+
+func expandChaosStudioExperimentAction(input ChaosStudioExperimentActionModel, selectorNames map[string]struct{}) (azuresdkhacks.Action, error) {
+	if input.ActionType == chaosStudioActionTypeDelay {
+		if input.Duration == "" {
+			return azuresdkhacks.Action{}, fmt.Errorf("`duration` must be specified for a %q action", input.ActionType)
+		}
+		if len(input.Parameters) > 0 || input.SelectorName != "" {
+			return azuresdkhacks.Action{}, fmt.Errorf("`parameters` and `selector_name` cannot be specified for a %q action", input.ActionType)
+		}
+
+		urn := input.Urn
+		if urn == "" {
+			urn = chaosStudioTimedDelayUrn
+		}
+
+		return azuresdkhacks.Action{
+			Name:     urn,
+			Type:     chaosStudioActionTypeDelay,
+			Duration: pointer.To(input.Duration),
+		}, nil
+	}
+
+	if input.Urn == "" || input.SelectorName == "" {
+		return azuresdkhacks.Action{}, fmt.Errorf("`urn` and `selector_name` must be specified for a %q action", input.ActionType)
+	}
+	if _, ok := selectorNames[input.SelectorName]; !ok {
+		return azuresdkhacks.Action{}, fmt.Errorf("the selector %q was not found within `selectors`", input.SelectorName)
+	}
+
+	parameters := expandChaosStudioExperimentActionParameters(input.Parameters)
+
+	if input.ActionType == chaosStudioActionTypeContinuous {
+		if input.Duration == "" {
+			return azuresdkhacks.Action{}, fmt.Errorf("`duration` must be specified for a %q action", input.ActionType)
+		}
+
+		return azuresdkhacks.Action{
+			Name:       input.Urn,
+			Type:       chaosStudioActionTypeContinuous,
+			Duration:   pointer.To(input.Duration),
+			Parameters: pointer.To(parameters),
+			SelectorId: pointer.To(input.SelectorName),
+		}, nil
+	}
+
+	if input.Duration != "" {
+		return azuresdkhacks.Action{}, fmt.Errorf("`duration` cannot be specified for a %q action", input.ActionType)
+	}
+
+	return azuresdkhacks.Action{
+		Name:       input.Urn,
+		Type:       chaosStudioActionTypeDiscrete,
+		Parameters: pointer.To(parameters),
+		SelectorId: pointer.To(input.SelectorName),
+	}, nil
+}
+
+func expandChaosStudioExperimentActionParameters(input map[string]string) []azuresdkhacks.KeyValuePair {
+	// sorted so that the payload is stable between plans
+	keys := make([]string, 0, len(input))
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	output := make([]azuresdkhacks.KeyValuePair, 0)
+	for _, k := range keys {
+		output = append(output, azuresdkhacks.KeyValuePair{
+			Key:   k,
+			Value: input[k],
+		})
+	}
+	return output
+}
+
+func flattenChaosStudioExperimentSelectors(input []experiments.Selector) ([]ChaosStudioExperimentSelectorModel, error) {
+	output := make([]ChaosStudioExperimentSelectorModel, 0)
+	for _, selector := range input {
+		targetIds := make([]string, 0)
+		for _, target := range selector.Targets {
+			targetId, err := targets.ParseTargetIDInsensitively(target.Id)
+			if err != nil {
+				return nil, err
+			}
+			targetIds = append(targetIds, targetId.ID())
+		}
+
+		output = append(output, ChaosStudioExperimentSelectorModel{
+			Name:                 selector.Id,
+			ChaosStudioTargetIds: targetIds,
+		})
+	}
+	return output, nil
+}
+
+func flattenChaosStudioExperimentSteps(input []azuresdkhacks.Step) ([]ChaosStudioExperimentStepModel, error) {
+	output := make([]ChaosStudioExperimentStepModel, 0)
+	for _, step := range input {
+		branches := make([]ChaosStudioExperimentBranchModel, 0)
+		for _, branch := range step.Branches {
+			actions := make([]ChaosStudioExperimentActionModel, 0)
+			for _, action := range branch.Actions {
+				switch actionType := strings.ToLower(action.Type); actionType {
+				case chaosStudioActionTypeContinuous:
+					actions = append(actions, ChaosStudioExperimentActionModel{
+						ActionType:   actionType,
+						Urn:          action.Name,
+						Duration:     pointer.From(action.Duration),
+						Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+						SelectorName: pointer.From(action.SelectorId),
+					})
+				case chaosStudioActionTypeDelay:
+					actions = append(actions, ChaosStudioExperimentActionModel{
+						ActionType: actionType,
+						Urn:        action.Name,
+						Duration:   pointer.From(action.Duration),
+					})
+				case chaosStudioActionTypeDiscrete:
+					actions = append(actions, ChaosStudioExperimentActionModel{
+						ActionType:   actionType,
+						Urn:          action.Name,
+						Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+						SelectorName: pointer.From(action.SelectorId),
+					})
+				default:
+					return nil, fmt.Errorf("unsupported action type %q in branch %q of step %q", action.Type, branch.Name, step.Name)
+				}
+			}
+
+			branches = append(branches, ChaosStudioExperimentBranchModel{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		output = append(output, ChaosStudioExperimentStepModel{
+			Name:   step.Name,
+			Branch: branches,
+		})
+	}
+	return output, nil
+}
+
+func flattenChaosStudioExperimentActionParameters(input *[]azuresdkhacks.KeyValuePair) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output[v.Key] = v.Value
+	}
+	return output
+}
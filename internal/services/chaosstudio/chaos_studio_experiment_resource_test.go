@@ -0,0 +1,236 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+func TestAccChaosStudioExperiment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccChaosStudioExperiment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").IsUUID(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ChaosStudioExperimentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExperimentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ChaosStudio.ExperimentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioExperimentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-ce-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  steps {
+    name = "acctest-step"
+
+    branch {
+      name = "acctest-branch"
+
+      actions {
+        action_type = "delay"
+        duration    = "PT5M"
+      }
+    }
+  }
+}
+`, ChaosStudioCapabilityResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ChaosStudioExperimentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "import" {
+  name                = azurerm_chaos_studio_experiment.test.name
+  resource_group_name = azurerm_chaos_studio_experiment.test.resource_group_name
+  location            = azurerm_chaos_studio_experiment.test.location
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  steps {
+    name = "acctest-step"
+
+    branch {
+      name = "acctest-branch"
+
+      actions {
+        action_type = "delay"
+        duration    = "PT5M"
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ChaosStudioExperimentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-ce-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selectors {
+    name                    = "Selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  steps {
+    name = "acctest-step"
+
+    branch {
+      name = "acctest-branch"
+
+      actions {
+        action_type   = "continuous"
+        urn           = azurerm_chaos_studio_capability.test.urn
+        selector_name = "Selector1"
+        duration      = "PT10M"
+        parameters = {
+          abruptShutdown = "false"
+        }
+      }
+
+      actions {
+        action_type = "delay"
+        duration    = "PT5M"
+      }
+    }
+  }
+
+  steps {
+    name = "acctest-step-2"
+
+    branch {
+      name = "acctest-branch"
+
+      actions {
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_linux_virtual_machine.test.id
+  role_definition_name = "Virtual Machine Contributor"
+  principal_id         = azurerm_chaos_studio_experiment.test.identity.0.principal_id
+}
+`, ChaosStudioCapabilityResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,160 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioTargetModel struct {
+	Location         string `tfschema:"location"`
+	TargetResourceId string `tfschema:"target_resource_id"`
+	TargetType       string `tfschema:"target_type"`
+}
+
+type ChaosStudioTargetResource struct{}
+
+var _ sdk.Resource = ChaosStudioTargetResource{}
+
+func (r ChaosStudioTargetResource) ResourceType() string {
+	return "azurerm_chaos_studio_target"
+}
+
+func (r ChaosStudioTargetResource) ModelObject() interface{} {
+	return &ChaosStudioTargetModel{}
+}
+
+func (r ChaosStudioTargetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return targets.ValidateTargetID
+}
+
+func (r ChaosStudioTargetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.Location(),
+
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: targets.ValidateProviderID,
+		},
+
+		// the Target Types available for a resource can be listed through the `Microsoft.Chaos/locations/targetTypes` API,
+		// e.g. `Microsoft-VirtualMachine`, `Microsoft-VirtualMachineScaleSet`, `Microsoft-AzureKubernetesServiceChaosMesh` or `Microsoft-CosmosDB`
+		"target_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioTargetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			var model ChaosStudioTargetModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resourceId, err := targets.ParseProviderID(model.TargetResourceId)
+			if err != nil {
+				return err
+			}
+
+			id := targets.NewTargetID(resourceId.SubscriptionId, resourceId.ResourceGroupName, resourceId.ProviderName, resourceId.ParentResourceType, resourceId.ParentResourceName, model.TargetType)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := targets.Target{
+				Location:   pointer.To(location.Normalize(model.Location)),
+				Properties: map[string]interface{}{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioTargetModel{
+				TargetResourceId: targets.NewProviderID(id.SubscriptionId, id.ResourceGroupName, id.ProviderName, id.ParentResourceType, id.ParentResourceName).ID(),
+				TargetType:       id.TargetName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ChaosStudioTargetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/capabilities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/experiments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+type Client struct {
+	CapabilitiesClient *capabilities.CapabilitiesClient
+	ExperimentsClient  *experiments.ExperimentsClient
+	TargetsClient      *targets.TargetsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	capabilitiesClient, err := capabilities.NewCapabilitiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(capabilitiesClient.Client, o.Authorizers.ResourceManager)
+
+	experimentsClient, err := experiments.NewExperimentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(experimentsClient.Client, o.Authorizers.ResourceManager)
+
+	targetsClient, err := targets.NewTargetsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(targetsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		CapabilitiesClient: capabilitiesClient,
+		ExperimentsClient:  experimentsClient,
+		TargetsClient:      targetsClient,
+	}, nil
+}
@@ -408,6 +408,11 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 			return fmt.Errorf("`source_resource_id` must be specified when `create_option` is set to `Copy` or `Restore`")
 		}
 
+		// a Disk Restore Point can only be used as the source when restoring
+		if _, err := parse.DiskRestorePointID(sourceResourceId); err == nil && createOption != disks.DiskCreateOptionRestore {
+			return fmt.Errorf("`create_option` must be set to `Restore` when `source_resource_id` is a Disk Restore Point")
+		}
+
 		props.CreationData.SourceResourceId = utils.String(sourceResourceId)
 	}
 	if createOption == disks.DiskCreateOptionFromImage {
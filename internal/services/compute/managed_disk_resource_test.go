@@ -93,6 +93,20 @@ func TestAccManagedDisk_copy(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_fromDiskRestorePoint(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "restored")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.fromDiskRestorePoint(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
 func TestAccManagedDisk_fromPlatformImage(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -960,6 +974,21 @@ resource "azurerm_managed_disk" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (ManagedDiskResource) fromDiskRestorePoint(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "restored" {
+  name                 = "acctestd-restored-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Restore"
+  source_resource_id   = azurerm_restore_point.test.data_disk_restore_point_ids.0
+}
+`, RestorePointResource{}.basic(data), data.RandomInteger)
+}
+
 func (ManagedDiskResource) copy(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DiskRestorePointId struct {
+	SubscriptionId             string
+	ResourceGroup              string
+	RestorePointCollectionName string
+	RestorePointName           string
+	Name                       string
+}
+
+func NewDiskRestorePointID(subscriptionId, resourceGroup, restorePointCollectionName, restorePointName, name string) DiskRestorePointId {
+	return DiskRestorePointId{
+		SubscriptionId:             subscriptionId,
+		ResourceGroup:              resourceGroup,
+		RestorePointCollectionName: restorePointCollectionName,
+		RestorePointName:           restorePointName,
+		Name:                       name,
+	}
+}
+
+func (id DiskRestorePointId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Restore Point Name %q", id.RestorePointName),
+		fmt.Sprintf("Restore Point Collection Name %q", id.RestorePointCollectionName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Disk Restore Point", segmentsStr)
+}
+
+func (id DiskRestorePointId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/restorePointCollections/%s/restorePoints/%s/diskRestorePoints/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RestorePointCollectionName, id.RestorePointName, id.Name)
+}
+
+// DiskRestorePointID parses a DiskRestorePoint ID into an DiskRestorePointId struct
+func DiskRestorePointID(input string) (*DiskRestorePointId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DiskRestorePointId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.RestorePointCollectionName, err = id.PopSegment("restorePointCollections"); err != nil {
+		return nil, err
+	}
+	if resourceId.RestorePointName, err = id.PopSegment("restorePoints"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("diskRestorePoints"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -85,5 +85,8 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
+		RestorePointCollectionResource{},
+		RestorePointResource{},
+		VirtualMachineRunCommandResource{},
 	}
 }
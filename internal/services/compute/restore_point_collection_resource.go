@@ -0,0 +1,228 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type RestorePointCollectionResource struct{}
+
+var _ sdk.ResourceWithUpdate = RestorePointCollectionResource{}
+
+type RestorePointCollectionModel struct {
+	Name                           string            `tfschema:"name"`
+	ResourceGroupName              string            `tfschema:"resource_group_name"`
+	Location                       string            `tfschema:"location"`
+	SourceVirtualMachineId         string            `tfschema:"source_virtual_machine_id"`
+	SourceRestorePointCollectionId string            `tfschema:"source_restore_point_collection_id"`
+	Tags                           map[string]string `tfschema:"tags"`
+}
+
+func (r RestorePointCollectionResource) ResourceType() string {
+	return "azurerm_restore_point_collection"
+}
+
+func (r RestorePointCollectionResource) ModelObject() interface{} {
+	return &RestorePointCollectionModel{}
+}
+
+func (r RestorePointCollectionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RestorePointCollectionID
+}
+
+func (r RestorePointCollectionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"source_virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+			ExactlyOneOf: []string{"source_virtual_machine_id", "source_restore_point_collection_id"},
+		},
+
+		// used to copy Restore Points into another region
+		"source_restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointCollectionID,
+			ExactlyOneOf: []string{"source_virtual_machine_id", "source_restore_point_collection_id"},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r RestorePointCollectionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r RestorePointCollectionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model RestorePointCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewRestorePointCollectionID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sourceId := model.SourceVirtualMachineId
+			if model.SourceRestorePointCollectionId != "" {
+				sourceId = model.SourceRestorePointCollectionId
+			}
+
+			input := compute.RestorePointCollection{
+				Location: utils.String(location.Normalize(model.Location)),
+				RestorePointCollectionProperties: &compute.RestorePointCollectionProperties{
+					Source: &compute.RestorePointCollectionSourceProperties{
+						ID: utils.String(sourceId),
+					},
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RestorePointCollectionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := parse.RestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model RestorePointCollectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				input := compute.RestorePointCollectionUpdate{
+					Tags: tags.FromTypedObject(model.Tags),
+				}
+
+				if _, err := client.Update(ctx, id.ResourceGroup, id.Name, input); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r RestorePointCollectionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := parse.RestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := RestorePointCollectionModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(resp.Location),
+				Tags:              tags.ToTypedObject(resp.Tags),
+			}
+
+			if props := resp.RestorePointCollectionProperties; props != nil && props.Source != nil && props.Source.ID != nil {
+				// the source is either a Virtual Machine or (when copying across regions) another Restore Point Collection
+				if sourceId, err := parse.RestorePointCollectionID(*props.Source.ID); err == nil {
+					state.SourceRestorePointCollectionId = sourceId.ID()
+				} else {
+					virtualMachineId, err := parse.VirtualMachineID(*props.Source.ID)
+					if err != nil {
+						return fmt.Errorf("parsing `source_virtual_machine_id`: %+v", err)
+					}
+					state.SourceVirtualMachineId = virtualMachineId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RestorePointCollectionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+
+			id, err := parse.RestorePointCollectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,273 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/disks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type RestorePointResource struct{}
+
+var _ sdk.Resource = RestorePointResource{}
+
+type RestorePointModel struct {
+	Name                     string   `tfschema:"name"`
+	RestorePointCollectionId string   `tfschema:"restore_point_collection_id"`
+	ConsistencyMode          string   `tfschema:"consistency_mode"`
+	ExcludedDiskIds          []string `tfschema:"excluded_disk_ids"`
+	SourceRestorePointId     string   `tfschema:"source_restore_point_id"`
+	TimeCreated              string   `tfschema:"time_created"`
+	OsDiskRestorePointId     string   `tfschema:"os_disk_restore_point_id"`
+	DataDiskRestorePointIds  []string `tfschema:"data_disk_restore_point_ids"`
+}
+
+func (r RestorePointResource) ResourceType() string {
+	return "azurerm_restore_point"
+}
+
+func (r RestorePointResource) ModelObject() interface{} {
+	return &RestorePointModel{}
+}
+
+func (r RestorePointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RestorePointID
+}
+
+func (r RestorePointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointCollectionID,
+		},
+
+		"consistency_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(compute.ConsistencyModeTypesApplicationConsistent),
+				string(compute.ConsistencyModeTypesCrashConsistent),
+			}, false),
+			ConflictsWith: []string{"source_restore_point_id"},
+		},
+
+		"excluded_disk_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: disks.ValidateDiskID,
+			},
+			ConflictsWith: []string{"source_restore_point_id"},
+		},
+
+		// used to copy a Restore Point from a Restore Point Collection in another region
+		"source_restore_point_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointID,
+		},
+	}
+}
+
+func (r RestorePointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"time_created": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"os_disk_restore_point_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"data_disk_restore_point_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r RestorePointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model RestorePointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Compute.RestorePointsClient
+			collectionId, err := parse.RestorePointCollectionID(model.RestorePointCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.Name, model.Name)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := compute.RestorePointProperties{
+				ConsistencyMode: compute.ConsistencyModeTypes(model.ConsistencyMode),
+			}
+
+			if len(model.ExcludedDiskIds) > 0 {
+				excludedDisks := make([]compute.APIEntityReference, 0)
+				for _, v := range model.ExcludedDiskIds {
+					excludedDisks = append(excludedDisks, compute.APIEntityReference{
+						ID: utils.String(v),
+					})
+				}
+				props.ExcludeDisks = &excludedDisks
+			}
+
+			if model.SourceRestorePointId != "" {
+				props.SourceRestorePoint = &compute.APIEntityReference{
+					ID: utils.String(model.SourceRestorePointId),
+				}
+			}
+
+			input := compute.RestorePoint{
+				RestorePointProperties: &props,
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, input)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r RestorePointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := RestorePointModel{
+				Name:                     id.Name,
+				RestorePointCollectionId: parse.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroup, id.RestorePointCollectionName).ID(),
+				ExcludedDiskIds:          []string{},
+				DataDiskRestorePointIds:  []string{},
+			}
+
+			if props := resp.RestorePointProperties; props != nil {
+				state.ConsistencyMode = string(props.ConsistencyMode)
+
+				if props.ExcludeDisks != nil {
+					for _, v := range *props.ExcludeDisks {
+						if v.ID == nil {
+							continue
+						}
+						diskId, err := disks.ParseDiskIDInsensitively(*v.ID)
+						if err != nil {
+							return fmt.Errorf("parsing `excluded_disk_ids`: %+v", err)
+						}
+						state.ExcludedDiskIds = append(state.ExcludedDiskIds, diskId.ID())
+					}
+				}
+
+				if props.SourceRestorePoint != nil && props.SourceRestorePoint.ID != nil {
+					state.SourceRestorePointId = *props.SourceRestorePoint.ID
+				}
+
+				if props.TimeCreated != nil {
+					state.TimeCreated = props.TimeCreated.Format(time.RFC3339)
+				}
+
+				if sourceMetadata := props.SourceMetadata; sourceMetadata != nil && sourceMetadata.StorageProfile != nil {
+					if osDisk := sourceMetadata.StorageProfile.OsDisk; osDisk != nil && osDisk.DiskRestorePoint != nil {
+						state.OsDiskRestorePointId = utils.NormalizeNilableString(osDisk.DiskRestorePoint.ID)
+					}
+
+					if dataDisks := sourceMetadata.StorageProfile.DataDisks; dataDisks != nil {
+						for _, v := range *dataDisks {
+							if v.DiskRestorePoint != nil && v.DiskRestorePoint.ID != nil {
+								state.DataDiskRestorePointIds = append(state.DataDiskRestorePointIds, *v.DiskRestorePoint.ID)
+							}
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r RestorePointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
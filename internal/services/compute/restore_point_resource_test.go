@@ -0,0 +1,157 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RestorePointResource struct{}
+
+func TestAccRestorePoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("os_disk_restore_point_id").Exists(),
+				check.That(data.ResourceName).Key("data_disk_restore_point_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRestorePoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRestorePoint_crashConsistentExcludedDisks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crashConsistentExcludedDisks(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consistency_mode").HasValue("CrashConsistent"),
+				check.That(data.ResourceName).Key("data_disk_restore_point_ids.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRestorePoint_crossRegionCopy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegionCopy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_restore_point.copy").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RestorePointResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RestorePointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.RestorePointsClient.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r RestorePointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_restore_point" "test" {
+  name                        = "acctestrp-%d"
+  restore_point_collection_id = azurerm_restore_point_collection.test.id
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, RestorePointCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r RestorePointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_restore_point" "import" {
+  name                        = azurerm_restore_point.test.name
+  restore_point_collection_id = azurerm_restore_point.test.restore_point_collection_id
+}
+`, r.basic(data))
+}
+
+func (r RestorePointResource) crashConsistentExcludedDisks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_restore_point" "test" {
+  name                        = "acctestrp-%d"
+  restore_point_collection_id = azurerm_restore_point_collection.test.id
+  consistency_mode            = "CrashConsistent"
+  excluded_disk_ids           = [azurerm_managed_disk.test.id]
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, RestorePointCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r RestorePointResource) crossRegionCopy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "copy" {
+  name     = "acctestRG-copy-%[2]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_restore_point_collection" "copy" {
+  name                               = "acctestrpc-copy-%[2]d"
+  resource_group_name                = azurerm_resource_group.copy.name
+  location                           = azurerm_resource_group.copy.location
+  source_restore_point_collection_id = azurerm_restore_point_collection.test.id
+}
+
+resource "azurerm_restore_point" "copy" {
+  name                        = "acctestrp-copy-%[2]d"
+  restore_point_collection_id = azurerm_restore_point_collection.copy.id
+  source_restore_point_id     = azurerm_restore_point.test.id
+}
+`, r.basic(data), data.RandomInteger, data.Locations.Secondary)
+}
@@ -0,0 +1,526 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type VirtualMachineRunCommandResource struct{}
+
+var _ sdk.ResourceWithUpdate = VirtualMachineRunCommandResource{}
+
+type VirtualMachineRunCommandModel struct {
+	Name               string                                 `tfschema:"name"`
+	VirtualMachineId   string                                 `tfschema:"virtual_machine_id"`
+	Location           string                                 `tfschema:"location"`
+	Source             []VirtualMachineRunCommandSourceModel  `tfschema:"source"`
+	Parameter          []VirtualMachineRunCommandParameter    `tfschema:"parameter"`
+	ProtectedParameter []VirtualMachineRunCommandParameter    `tfschema:"protected_parameter"`
+	RunAsUser          string                                 `tfschema:"run_as_user"`
+	RunAsPassword      string                                 `tfschema:"run_as_password"`
+	AsyncExecution     bool                                   `tfschema:"async_execution"`
+	TimeoutInSeconds   int                                    `tfschema:"timeout_in_seconds"`
+	OutputBlobUri      string                                 `tfschema:"output_blob_uri"`
+	ErrorBlobUri       string                                 `tfschema:"error_blob_uri"`
+	Tags               map[string]string                      `tfschema:"tags"`
+	InstanceView       []VirtualMachineRunCommandInstanceView `tfschema:"instance_view"`
+}
+
+type VirtualMachineRunCommandSourceModel struct {
+	Script    string `tfschema:"script"`
+	ScriptUri string `tfschema:"script_uri"`
+	CommandId string `tfschema:"command_id"`
+}
+
+type VirtualMachineRunCommandParameter struct {
+	Name  string `tfschema:"name"`
+	Value string `tfschema:"value"`
+}
+
+type VirtualMachineRunCommandInstanceView struct {
+	ExecutionState   string `tfschema:"execution_state"`
+	ExecutionMessage string `tfschema:"execution_message"`
+	ExitCode         int    `tfschema:"exit_code"`
+	Output           string `tfschema:"output"`
+	Error            string `tfschema:"error"`
+	StartTime        string `tfschema:"start_time"`
+	EndTime          string `tfschema:"end_time"`
+}
+
+func (r VirtualMachineRunCommandResource) ResourceType() string {
+	return "azurerm_virtual_machine_run_command"
+}
+
+func (r VirtualMachineRunCommandResource) ModelObject() interface{} {
+	return &VirtualMachineRunCommandModel{}
+}
+
+func (r VirtualMachineRunCommandResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineRunCommandID
+}
+
+func (r VirtualMachineRunCommandResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		"location": commonschema.Location(),
+
+		"source": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"script": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+
+					"command_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						ExactlyOneOf: []string{"source.0.script", "source.0.script_uri", "source.0.command_id"},
+					},
+				},
+			},
+		},
+
+		"parameter": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"protected_parameter": {
+			Type:      pluginsdk.TypeList,
+			Optional:  true,
+			Sensitive: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"run_as_user": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"run_as_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			RequiredWith: []string{"run_as_user"},
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"async_execution": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"timeout_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		// these can contain a SAS Token, which the API doesn't return
+		"output_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"error_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"instance_view": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"execution_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"execution_message": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"exit_code": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"output": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"error": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"start_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"end_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Compute.VMRunCommandsClient
+			virtualMachineId, err := parse.VirtualMachineID(model.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineRunCommandID(virtualMachineId.SubscriptionId, virtualMachineId.ResourceGroup, virtualMachineId.Name, model.Name)
+			existing, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := createOrUpdateVirtualMachineRunCommand(ctx, client, id, model); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model VirtualMachineRunCommandModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the protected values aren't returned by the API, so the whole Run Command has to be sent (which re-runs the script)
+			if err := createOrUpdateVirtualMachineRunCommand(ctx, client, *id, model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetByVirtualMachine(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, "instanceView")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := VirtualMachineRunCommandModel{
+				Name:             id.RunCommandName,
+				VirtualMachineId: parse.NewVirtualMachineID(id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName).ID(),
+				Location:         location.NormalizeNilable(resp.Location),
+				Tags:             tags.ToTypedObject(resp.Tags),
+			}
+
+			// the sensitive values aren't returned by the API, so we look these up from the existing state
+			var existingState VirtualMachineRunCommandModel
+			if err := metadata.Decode(&existingState); err == nil {
+				state.ProtectedParameter = existingState.ProtectedParameter
+				state.RunAsPassword = existingState.RunAsPassword
+				state.OutputBlobUri = existingState.OutputBlobUri
+				state.ErrorBlobUri = existingState.ErrorBlobUri
+			}
+
+			if props := resp.VirtualMachineRunCommandProperties; props != nil {
+				state.Source = flattenVirtualMachineRunCommandSource(props.Source)
+				state.Parameter = flattenVirtualMachineRunCommandParameters(props.Parameters)
+				state.RunAsUser = utils.NormalizeNilableString(props.RunAsUser)
+				state.AsyncExecution = utils.NormaliseNilableBool(props.AsyncExecution)
+				if props.TimeoutInSeconds != nil {
+					state.TimeoutInSeconds = int(*props.TimeoutInSeconds)
+				}
+				state.InstanceView = flattenVirtualMachineRunCommandInstanceView(props.InstanceView)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualMachineRunCommandResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 90 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMRunCommandsClient
+
+			id, err := parse.VirtualMachineRunCommandID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for the deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func createOrUpdateVirtualMachineRunCommand(ctx context.Context, client *compute.VirtualMachineRunCommandsClient, id parse.VirtualMachineRunCommandId, model VirtualMachineRunCommandModel) error {
+	props := compute.VirtualMachineRunCommandProperties{
+		Source:              expandVirtualMachineRunCommandSource(model.Source),
+		Parameters:          expandVirtualMachineRunCommandParameters(model.Parameter),
+		ProtectedParameters: expandVirtualMachineRunCommandParameters(model.ProtectedParameter),
+		AsyncExecution:      utils.Bool(model.AsyncExecution),
+	}
+
+	if model.RunAsUser != "" {
+		props.RunAsUser = utils.String(model.RunAsUser)
+	}
+
+	if model.RunAsPassword != "" {
+		props.RunAsPassword = utils.String(model.RunAsPassword)
+	}
+
+	if model.TimeoutInSeconds != 0 {
+		props.TimeoutInSeconds = utils.Int32(int32(model.TimeoutInSeconds))
+	}
+
+	if model.OutputBlobUri != "" {
+		props.OutputBlobURI = utils.String(model.OutputBlobUri)
+	}
+
+	if model.ErrorBlobUri != "" {
+		props.ErrorBlobURI = utils.String(model.ErrorBlobUri)
+	}
+
+	input := compute.VirtualMachineRunCommand{
+		Location:                           utils.String(location.Normalize(model.Location)),
+		VirtualMachineRunCommandProperties: &props,
+		Tags:                               tags.FromTypedObject(model.Tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineName, id.RunCommandName, input)
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for completion: %+v", err)
+	}
+
+	return nil
+}
+
+func expandVirtualMachineRunCommandSource(input []VirtualMachineRunCommandSourceModel) *compute.VirtualMachineRunCommandScriptSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	source := input[0]
+	output := compute.VirtualMachineRunCommandScriptSource{}
+	if source.Script != "" {
+		output.Script = utils.String(source.Script)
+	}
+	if source.ScriptUri != "" {
+		output.ScriptURI = utils.String(source.ScriptUri)
+	}
+	if source.CommandId != "" {
+		output.CommandID = utils.String(source.CommandId)
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandSource(input *compute.VirtualMachineRunCommandScriptSource) []VirtualMachineRunCommandSourceModel {
+	if input == nil {
+		return []VirtualMachineRunCommandSourceModel{}
+	}
+
+	return []VirtualMachineRunCommandSourceModel{
+		{
+			Script:    utils.NormalizeNilableString(input.Script),
+			ScriptUri: utils.NormalizeNilableString(input.ScriptURI),
+			CommandId: utils.NormalizeNilableString(input.CommandID),
+		},
+	}
+}
+
+func expandVirtualMachineRunCommandParameters(input []VirtualMachineRunCommandParameter) *[]compute.RunCommandInputParameter {
+	output := make([]compute.RunCommandInputParameter, 0)
+	for _, v := range input {
+		output = append(output, compute.RunCommandInputParameter{
+			Name:  utils.String(v.Name),
+			Value: utils.String(v.Value),
+		})
+	}
+
+	return &output
+}
+
+func flattenVirtualMachineRunCommandParameters(input *[]compute.RunCommandInputParameter) []VirtualMachineRunCommandParameter {
+	output := make([]VirtualMachineRunCommandParameter, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, VirtualMachineRunCommandParameter{
+			Name:  utils.NormalizeNilableString(v.Name),
+			Value: utils.NormalizeNilableString(v.Value),
+		})
+	}
+
+	return output
+}
+
+func flattenVirtualMachineRunCommandInstanceView(input *compute.VirtualMachineRunCommandInstanceView) []VirtualMachineRunCommandInstanceView {
+	if input == nil {
+		return []VirtualMachineRunCommandInstanceView{}
+	}
+
+	output := VirtualMachineRunCommandInstanceView{
+		ExecutionState:   string(input.ExecutionState),
+		ExecutionMessage: utils.NormalizeNilableString(input.ExecutionMessage),
+		Output:           utils.NormalizeNilableString(input.Output),
+		Error:            utils.NormalizeNilableString(input.Error),
+	}
+
+	if input.ExitCode != nil {
+		output.ExitCode = int(*input.ExitCode)
+	}
+
+	if input.StartTime != nil {
+		output.StartTime = input.StartTime.Format(time.RFC3339)
+	}
+
+	if input.EndTime != nil {
+		output.EndTime = input.EndTime.Format(time.RFC3339)
+	}
+
+	return []VirtualMachineRunCommandInstanceView{output}
+}
@@ -4,7 +4,9 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/managedcassandras"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/postgresqlhsc"
 )
 
 type Client struct {
@@ -14,7 +16,12 @@ type Client struct {
 	DatabaseClient                   *documentdb.DatabaseAccountsClient
 	GremlinClient                    *documentdb.GremlinResourcesClient
 	MongoDbClient                    *documentdb.MongoDBResourcesClient
+	MongoRBACClient                  *mongorbacs.MongorbacsClient
 	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
+	PostgreSQLClustersClient         *postgresqlhsc.ClustersClient
+	PostgreSQLConfigurationsClient   *postgresqlhsc.ConfigurationsClient
+	PostgreSQLFirewallRulesClient    *postgresqlhsc.FirewallRulesClient
+	PostgreSQLRolesClient            *postgresqlhsc.RolesClient
 	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
 	SqlDedicatedGatewayClient        *sqldedicatedgateway.SqlDedicatedGatewayClient
 	SqlClient                        *documentdb.SQLResourcesClient
@@ -22,7 +29,7 @@ type Client struct {
 	TableClient                      *documentdb.TableResourcesClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	cassandraClient := documentdb.NewCassandraResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&cassandraClient.Client, o.ResourceManagerAuthorizer)
 
@@ -41,9 +48,36 @@ func NewClient(o *common.ClientOptions) *Client {
 	mongoDbClient := documentdb.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&mongoDbClient.Client, o.ResourceManagerAuthorizer)
 
+	mongoRBACClient := mongorbacs.NewMongorbacsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&mongoRBACClient.Client, o.ResourceManagerAuthorizer)
+
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	postgreSQLClustersClient, err := postgresqlhsc.NewClustersClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(postgreSQLClustersClient.Client, o.Authorizers.ResourceManager)
+
+	postgreSQLConfigurationsClient, err := postgresqlhsc.NewConfigurationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(postgreSQLConfigurationsClient.Client, o.Authorizers.ResourceManager)
+
+	postgreSQLFirewallRulesClient, err := postgresqlhsc.NewFirewallRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(postgreSQLFirewallRulesClient.Client, o.Authorizers.ResourceManager)
+
+	postgreSQLRolesClient, err := postgresqlhsc.NewRolesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(postgreSQLRolesClient.Client, o.Authorizers.ResourceManager)
+
 	restorableDatabaseAccountsClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&restorableDatabaseAccountsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -66,11 +100,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabaseClient:                   &databaseClient,
 		GremlinClient:                    &gremlinClient,
 		MongoDbClient:                    &mongoDbClient,
+		MongoRBACClient:                  &mongoRBACClient,
 		NotebookWorkspaceClient:          &notebookWorkspaceClient,
+		PostgreSQLClustersClient:         postgreSQLClustersClient,
+		PostgreSQLConfigurationsClient:   postgreSQLConfigurationsClient,
+		PostgreSQLFirewallRulesClient:    postgreSQLFirewallRulesClient,
+		PostgreSQLRolesClient:            postgreSQLRolesClient,
 		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
 		SqlDedicatedGatewayClient:        &sqlDedicatedGatewayClient,
 		SqlClient:                        &sqlClient,
 		SqlResourceClient:                &sqlResourceClient,
 		TableClient:                      &tableClient,
-	}
+	}, nil
 }
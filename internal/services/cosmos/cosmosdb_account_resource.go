@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
@@ -154,6 +155,8 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 				}
 				return nil
 			}),
+
+			restore.ValidatePointInTimeInDiff("restore.0.source_cosmosdb_account_id", "restore.0.restore_timestamp_in_utc", cosmosDbAccountRestoreWindow),
 		),
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -547,12 +550,12 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 							ValidateFunc: validate.RestorableDatabaseAccountID,
 						},
 
-						"restore_timestamp_in_utc": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: validation.IsRFC3339Time,
-						},
+						"restore_timestamp_in_utc": func() *pluginsdk.Schema {
+							s := restore.PointInTimeSchema()
+							s.Optional = false
+							s.Required = true
+							return s
+						}(),
 
 						"database": {
 							Type:     pluginsdk.TypeSet,
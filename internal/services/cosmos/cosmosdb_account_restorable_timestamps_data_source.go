@@ -0,0 +1,101 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// cosmosDbContinuousBackupMaxRetention is the longest period which Continuous Backup retains restore points for
+const cosmosDbContinuousBackupMaxRetention = 30 * 24 * time.Hour
+
+func dataSourceCosmosDbAccountRestorableTimestamps() *pluginsdk.Resource {
+	s := map[string]*pluginsdk.Schema{
+		"restorable_database_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.RestorableDatabaseAccountID,
+		},
+	}
+	for k, v := range restore.SchemaDataSource() {
+		s[k] = v
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceCosmosDbAccountRestorableTimestampsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceCosmosDbAccountRestorableTimestampsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.RestorableDatabaseAccountID(d.Get("restorable_database_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	window, err := cosmosDbAccountRestoreWindow(ctx, meta, id.ID())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("restorable_database_account_id", id.ID())
+
+	return window.Flatten(d)
+}
+
+// cosmosDbAccountRestoreWindow returns the range of time which the Restorable Database Account can be restored to
+func cosmosDbAccountRestoreWindow(ctx context.Context, meta interface{}, sourceId string) (*restore.Window, error) {
+	client := meta.(*clients.Client).Cosmos.RestorableDatabaseAccountsClient
+
+	id, err := parse.RestorableDatabaseAccountID(sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetByLocation(ctx, id.LocationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("%s was not found", *id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := resp.RestorableDatabaseAccountProperties
+	if props == nil || props.CreationTime == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.creationTime` was nil", *id)
+	}
+
+	now := time.Now().UTC()
+	latest := now
+	if props.DeletionTime != nil {
+		latest = props.DeletionTime.Time
+	}
+
+	// restore points older than the retention period have been discarded, even when the account was created before it
+	earliest := props.CreationTime.Time
+	if retained := now.Add(-cosmosDbContinuousBackupMaxRetention); earliest.Before(retained) {
+		earliest = retained
+	}
+
+	return &restore.Window{
+		Earliest: earliest,
+		Latest:   latest,
+	}, nil
+}
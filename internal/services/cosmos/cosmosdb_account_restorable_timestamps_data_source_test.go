@@ -0,0 +1,36 @@
+package cosmos_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CosmosDbAccountRestorableTimestampsDataSource struct{}
+
+func TestAccDataSourceCosmosDbAccountRestorableTimestamps_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cosmosdb_account_restorable_timestamps", "test")
+	r := CosmosDbAccountRestorableTimestampsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("earliest_restore_time").Exists(),
+				check.That(data.ResourceName).Key("latest_restore_time").Exists(),
+			),
+		},
+	})
+}
+
+func (CosmosDbAccountRestorableTimestampsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_cosmosdb_account_restorable_timestamps" "test" {
+  restorable_database_account_id = data.azurerm_cosmosdb_restorable_database_accounts.test.accounts.0.id
+}
+`, CosmosDbRestorableDatabaseAccountsDataSourceResource{}.basic(data))
+}
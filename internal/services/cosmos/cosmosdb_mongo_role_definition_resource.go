@@ -0,0 +1,362 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbMongoRoleDefinitionModel struct {
+	CosmosMongoDatabaseId string                        `tfschema:"cosmos_mongo_database_id"`
+	RoleName              string                        `tfschema:"role_name"`
+	InheritedRoleNames    []string                      `tfschema:"inherited_role_names"`
+	Privileges            []CosmosDbMongoPrivilegeModel `tfschema:"privilege"`
+}
+
+type CosmosDbMongoPrivilegeModel struct {
+	Actions  []string                              `tfschema:"actions"`
+	Resource []CosmosDbMongoPrivilegeResourceModel `tfschema:"resource"`
+}
+
+type CosmosDbMongoPrivilegeResourceModel struct {
+	CollectionName string `tfschema:"collection_name"`
+	DbName         string `tfschema:"db_name"`
+}
+
+type CosmosDbMongoRoleDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbMongoRoleDefinitionResource{}
+
+func (r CosmosDbMongoRoleDefinitionResource) ResourceType() string {
+	return "azurerm_cosmosdb_mongo_role_definition"
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) ModelObject() interface{} {
+	return &CosmosDbMongoRoleDefinitionModel{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mongorbacs.ValidateMongodbRoleDefinitionID
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmos_mongo_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongodbDatabaseID,
+		},
+
+		"role_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"inherited_role_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"privilege": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"resource": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"collection_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"db_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.MongoRBACClient
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := mongorbacs.NewMongodbRoleDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, model.RoleName))
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			roleType := mongorbacs.MongoRoleDefinitionTypeCustomRole
+			parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+				Properties: &mongorbacs.MongoRoleDefinitionResource{
+					DatabaseName: pointer.To(databaseId.Name),
+					RoleName:     pointer.To(model.RoleName),
+					Type:         &roleType,
+					Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(model.Privileges),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+				},
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+				Properties: existing.Model.Properties,
+			}
+
+			if metadata.ResourceData.HasChange("inherited_role_names") {
+				parameters.Properties.Roles = expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames)
+			}
+
+			if metadata.ResourceData.HasChange("privilege") {
+				parameters.Properties.Privileges = expandCosmosDbMongoRoleDefinitionPrivileges(model.Privileges)
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbMongoRoleDefinitionModel{}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					databaseName := pointer.From(props.DatabaseName)
+					state.CosmosMongoDatabaseId = parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName, databaseName).ID()
+					state.RoleName = pointer.From(props.RoleName)
+					state.InheritedRoleNames = flattenCosmosDbMongoRoles(props.Roles)
+					state.Privileges = flattenCosmosDbMongoRoleDefinitionPrivileges(props.Privileges)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			if err := client.MongoDBResourcesDeleteMongoRoleDefinitionThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbMongoRoles(databaseName string, input []string) *[]mongorbacs.Role {
+	result := make([]mongorbacs.Role, 0)
+
+	for _, v := range input {
+		result = append(result, mongorbacs.Role{
+			Db:   pointer.To(databaseName),
+			Role: pointer.To(v),
+		})
+	}
+
+	return &result
+}
+
+func flattenCosmosDbMongoRoles(input *[]mongorbacs.Role) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, pointer.From(v.Role))
+	}
+
+	return result
+}
+
+func expandCosmosDbMongoRoleDefinitionPrivileges(input []CosmosDbMongoPrivilegeModel) *[]mongorbacs.Privilege {
+	result := make([]mongorbacs.Privilege, 0)
+
+	for _, v := range input {
+		privilege := mongorbacs.Privilege{
+			Actions: pointer.To(v.Actions),
+		}
+
+		if len(v.Resource) > 0 {
+			resource := mongorbacs.PrivilegeResource{}
+
+			if v.Resource[0].CollectionName != "" {
+				resource.Collection = pointer.To(v.Resource[0].CollectionName)
+			}
+
+			if v.Resource[0].DbName != "" {
+				resource.Db = pointer.To(v.Resource[0].DbName)
+			}
+
+			privilege.Resource = &resource
+		}
+
+		result = append(result, privilege)
+	}
+
+	return &result
+}
+
+func flattenCosmosDbMongoRoleDefinitionPrivileges(input *[]mongorbacs.Privilege) []CosmosDbMongoPrivilegeModel {
+	result := make([]CosmosDbMongoPrivilegeModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		privilege := CosmosDbMongoPrivilegeModel{
+			Actions:  pointer.From(v.Actions),
+			Resource: make([]CosmosDbMongoPrivilegeResourceModel, 0),
+		}
+
+		if v.Resource != nil {
+			privilege.Resource = append(privilege.Resource, CosmosDbMongoPrivilegeResourceModel{
+				CollectionName: pointer.From(v.Resource.Collection),
+				DbName:         pointer.From(v.Resource.Db),
+			})
+		}
+
+		result = append(result, privilege)
+	}
+
+	return result
+}
@@ -0,0 +1,241 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-11-15/mongorbacs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbMongoUserDefinitionModel struct {
+	CosmosMongoDatabaseId string   `tfschema:"cosmos_mongo_database_id"`
+	Username              string   `tfschema:"username"`
+	Password              string   `tfschema:"password"`
+	InheritedRoleNames    []string `tfschema:"inherited_role_names"`
+}
+
+type CosmosDbMongoUserDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbMongoUserDefinitionResource{}
+
+func (r CosmosDbMongoUserDefinitionResource) ResourceType() string {
+	return "azurerm_cosmosdb_mongo_user_definition"
+}
+
+func (r CosmosDbMongoUserDefinitionResource) ModelObject() interface{} {
+	return &CosmosDbMongoUserDefinitionModel{}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mongorbacs.ValidateMongodbUserDefinitionID
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmos_mongo_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongodbDatabaseID,
+		},
+
+		"username": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"inherited_role_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbMongoUserDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.MongoRBACClient
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			id := mongorbacs.NewMongodbUserDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, model.Username))
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := mongorbacs.MongoUserDefinitionCreateUpdateParameters{
+				Properties: &mongorbacs.MongoUserDefinitionResource{
+					DatabaseName: pointer.To(databaseId.Name),
+					UserName:     pointer.To(model.Username),
+					Password:     pointer.To(model.Password),
+					Mechanisms:   pointer.To("SCRAM-SHA-256"),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+				},
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoUserDefinitionThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbMongoUserDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			existing, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: properties was nil", *id)
+			}
+
+			// the password isn't returned by the API so it's always sent from the configuration
+			parameters := mongorbacs.MongoUserDefinitionCreateUpdateParameters{
+				Properties: existing.Model.Properties,
+			}
+			parameters.Properties.Password = pointer.To(model.Password)
+
+			if metadata.ResourceData.HasChange("inherited_role_names") {
+				parameters.Properties.Roles = expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames)
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoUserDefinitionThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MongoDBResourcesGetMongoUserDefinition(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbMongoUserDefinitionModel{
+				// the password is write-only, so it's retained from the configuration
+				Password: metadata.ResourceData.Get("password").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					databaseName := pointer.From(props.DatabaseName)
+					state.CosmosMongoDatabaseId = parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.DatabaseAccountName, databaseName).ID()
+					state.Username = pointer.From(props.UserName)
+					state.InheritedRoleNames = flattenCosmosDbMongoRoles(props.Roles)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbMongoUserDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbUserDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+			defer locks.UnlockByName(id.DatabaseAccountName, CosmosDbAccountResourceName)
+
+			if err := client.MongoDBResourcesDeleteMongoUserDefinitionThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,687 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/postgresqlhsc"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLClusterModel struct {
+	Name                             string                                            `tfschema:"name"`
+	ResourceGroupName                string                                            `tfschema:"resource_group_name"`
+	Location                         string                                            `tfschema:"location"`
+	AdministratorLoginPassword       string                                            `tfschema:"administrator_login_password"`
+	CoordinatorStorageQuotaInMb      int64                                             `tfschema:"coordinator_storage_quota_in_mb"`
+	CoordinatorVCoreCount            int64                                             `tfschema:"coordinator_vcore_count"`
+	NodeCount                        int64                                             `tfschema:"node_count"`
+	CitusVersion                     string                                            `tfschema:"citus_version"`
+	CoordinatorPublicIPAccessEnabled bool                                              `tfschema:"coordinator_public_ip_access_enabled"`
+	CoordinatorServerEdition         string                                            `tfschema:"coordinator_server_edition"`
+	HaEnabled                        bool                                              `tfschema:"ha_enabled"`
+	MaintenanceWindow                []CosmosDbPostgreSQLClusterMaintenanceWindowModel `tfschema:"maintenance_window"`
+	NodePublicIPAccessEnabled        bool                                              `tfschema:"node_public_ip_access_enabled"`
+	NodeServerEdition                string                                            `tfschema:"node_server_edition"`
+	NodeStorageQuotaInMb             int64                                             `tfschema:"node_storage_quota_in_mb"`
+	NodeVCores                       int64                                             `tfschema:"node_vcores"`
+	PointInTimeInUTC                 string                                            `tfschema:"point_in_time_in_utc"`
+	PreferredPrimaryZone             string                                            `tfschema:"preferred_primary_zone"`
+	ShardsOnCoordinatorEnabled       bool                                              `tfschema:"shards_on_coordinator_enabled"`
+	SourceLocation                   string                                            `tfschema:"source_location"`
+	SourceResourceId                 string                                            `tfschema:"source_resource_id"`
+	SqlVersion                       string                                            `tfschema:"sql_version"`
+	Tags                             map[string]string                                 `tfschema:"tags"`
+	EarliestRestoreTime              string                                            `tfschema:"earliest_restore_time"`
+	Servers                          []CosmosDbPostgreSQLClusterServerModel            `tfschema:"servers"`
+}
+
+type CosmosDbPostgreSQLClusterMaintenanceWindowModel struct {
+	DayOfWeek   int64 `tfschema:"day_of_week"`
+	StartHour   int64 `tfschema:"start_hour"`
+	StartMinute int64 `tfschema:"start_minute"`
+}
+
+type CosmosDbPostgreSQLClusterServerModel struct {
+	Fqdn string `tfschema:"fqdn"`
+	Name string `tfschema:"name"`
+}
+
+type CosmosDbPostgreSQLClusterResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = CosmosDbPostgreSQLClusterResource{}
+	_ sdk.ResourceWithCustomizeDiff = CosmosDbPostgreSQLClusterResource{}
+)
+
+func (r CosmosDbPostgreSQLClusterResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_cluster"
+}
+
+func (r CosmosDbPostgreSQLClusterResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLClusterModel{}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PostgresqlClusterID
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PostgresqlClusterName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"node_count": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.All(validation.IntBetween(0, 20), validation.IntNotInSlice([]int{1})),
+		},
+
+		"administrator_login_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"citus_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"8.3",
+				"9.0",
+				"9.1",
+				"9.2",
+				"9.3",
+				"9.4",
+				"9.5",
+				"10.0",
+				"10.1",
+				"10.2",
+				"11.0",
+				"11.1",
+				"11.2",
+				"11.3",
+			}, false),
+		},
+
+		"coordinator_public_ip_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"coordinator_server_edition": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "GeneralPurpose",
+			ValidateFunc: validation.StringInSlice([]string{
+				"BurstableGeneralPurpose",
+				"BurstableMemoryOptimized",
+				"GeneralPurpose",
+				"MemoryOptimized",
+			}, false),
+		},
+
+		"coordinator_storage_quota_in_mb": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				32768,
+				65536,
+				131072,
+				262144,
+				524288,
+				1048576,
+				2097152,
+				4194304,
+				8388608,
+				16777216,
+			}),
+		},
+
+		"coordinator_vcore_count": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				1,
+				2,
+				4,
+				8,
+				16,
+				32,
+				64,
+				96,
+			}),
+		},
+
+		"ha_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"maintenance_window": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"day_of_week": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 6),
+					},
+
+					"start_hour": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 23),
+					},
+
+					"start_minute": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 59),
+					},
+				},
+			},
+		},
+
+		"node_public_ip_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"node_server_edition": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "MemoryOptimized",
+			ValidateFunc: validation.StringInSlice([]string{
+				"GeneralPurpose",
+				"MemoryOptimized",
+			}, false),
+		},
+
+		"node_storage_quota_in_mb": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				32768,
+				65536,
+				131072,
+				262144,
+				524288,
+				1048576,
+				2097152,
+				4194304,
+				8388608,
+				16777216,
+			}),
+		},
+
+		"node_vcores": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				1,
+				2,
+				4,
+				8,
+				16,
+				32,
+				64,
+				96,
+				104,
+			}),
+		},
+
+		"point_in_time_in_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+			RequiredWith: []string{"source_resource_id"},
+		},
+
+		"preferred_primary_zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"shards_on_coordinator_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"source_location": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ForceNew:         true,
+			ValidateFunc:     location.EnhancedValidate,
+			StateFunc:        location.StateFunc,
+			DiffSuppressFunc: location.DiffSuppressFunc,
+			RequiredWith:     []string{"source_resource_id"},
+		},
+
+		"source_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PostgresqlClusterID,
+		},
+
+		"sql_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"11",
+				"12",
+				"13",
+				"14",
+				"15",
+			}, false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"earliest_restore_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"servers": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			// a Point in Time Restore or a Read Replica takes its credentials from the source cluster
+			if rd.Id() == "" {
+				if rd.Get("source_resource_id").(string) == "" && rd.Get("administrator_login_password").(string) == "" && rd.NewValueKnown("administrator_login_password") {
+					return fmt.Errorf("`administrator_login_password` is required when `source_resource_id` isn't specified")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgreSQLClustersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewPostgresqlClusterID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := postgresqlhsc.ClusterProperties{
+				CoordinatorEnablePublicIPAccess: pointer.To(model.CoordinatorPublicIPAccessEnabled),
+				CoordinatorServerEdition:        pointer.To(model.CoordinatorServerEdition),
+				EnableHa:                        pointer.To(model.HaEnabled),
+				MaintenanceWindow:               expandCosmosDbPostgreSQLClusterMaintenanceWindow(model.MaintenanceWindow),
+				NodeCount:                       pointer.To(model.NodeCount),
+				NodeEnablePublicIPAccess:        pointer.To(model.NodePublicIPAccessEnabled),
+				NodeServerEdition:               pointer.To(model.NodeServerEdition),
+			}
+
+			if model.AdministratorLoginPassword != "" {
+				properties.AdministratorLoginPassword = pointer.To(model.AdministratorLoginPassword)
+			}
+
+			if model.CitusVersion != "" {
+				properties.CitusVersion = pointer.To(model.CitusVersion)
+			}
+
+			if model.CoordinatorStorageQuotaInMb != 0 {
+				properties.CoordinatorStorageQuotaInMb = pointer.To(model.CoordinatorStorageQuotaInMb)
+			}
+
+			if model.CoordinatorVCoreCount != 0 {
+				properties.CoordinatorVCores = pointer.To(model.CoordinatorVCoreCount)
+			}
+
+			if model.NodeStorageQuotaInMb != 0 {
+				properties.NodeStorageQuotaInMb = pointer.To(model.NodeStorageQuotaInMb)
+			}
+
+			if model.NodeVCores != 0 {
+				properties.NodeVCores = pointer.To(model.NodeVCores)
+			}
+
+			if model.PreferredPrimaryZone != "" {
+				properties.PreferredPrimaryZone = pointer.To(model.PreferredPrimaryZone)
+			}
+
+			if v, ok := metadata.ResourceData.GetOkExists("shards_on_coordinator_enabled"); ok {
+				properties.EnableShardsOnCoordinator = pointer.To(v.(bool))
+			}
+
+			if model.SqlVersion != "" {
+				properties.PostgresqlVersion = pointer.To(model.SqlVersion)
+			}
+
+			// a Point in Time Restore is performed when `point_in_time_in_utc` is specified, otherwise a Read Replica of the source cluster is created
+			if model.SourceResourceId != "" {
+				properties.SourceResourceId = pointer.To(model.SourceResourceId)
+
+				if model.PointInTimeInUTC != "" {
+					properties.PointInTimeUTC = pointer.To(model.PointInTimeInUTC)
+				}
+			}
+
+			if model.SourceLocation != "" {
+				properties.SourceLocation = pointer.To(location.Normalize(model.SourceLocation))
+			}
+
+			parameters := postgresqlhsc.Cluster{
+				Location:   location.Normalize(model.Location),
+				Properties: &properties,
+				Tags:       pointer.To(model.Tags),
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLClustersClient
+
+			id, err := parse.PostgresqlClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbPostgreSQLClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := postgresqlhsc.ClusterPropertiesForUpdate{}
+			parameters := postgresqlhsc.ClusterForUpdate{}
+
+			if metadata.ResourceData.HasChange("administrator_login_password") {
+				properties.AdministratorLoginPassword = pointer.To(model.AdministratorLoginPassword)
+			}
+
+			if metadata.ResourceData.HasChange("citus_version") {
+				properties.CitusVersion = pointer.To(model.CitusVersion)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_public_ip_access_enabled") {
+				properties.CoordinatorEnablePublicIPAccess = pointer.To(model.CoordinatorPublicIPAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_server_edition") {
+				properties.CoordinatorServerEdition = pointer.To(model.CoordinatorServerEdition)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_storage_quota_in_mb") {
+				properties.CoordinatorStorageQuotaInMb = pointer.To(model.CoordinatorStorageQuotaInMb)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_vcore_count") {
+				properties.CoordinatorVCores = pointer.To(model.CoordinatorVCoreCount)
+			}
+
+			if metadata.ResourceData.HasChange("ha_enabled") {
+				properties.EnableHa = pointer.To(model.HaEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("maintenance_window") {
+				properties.MaintenanceWindow = expandCosmosDbPostgreSQLClusterMaintenanceWindow(model.MaintenanceWindow)
+			}
+
+			if metadata.ResourceData.HasChange("node_count") {
+				properties.NodeCount = pointer.To(model.NodeCount)
+			}
+
+			if metadata.ResourceData.HasChange("node_public_ip_access_enabled") {
+				properties.NodeEnablePublicIPAccess = pointer.To(model.NodePublicIPAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("node_server_edition") {
+				properties.NodeServerEdition = pointer.To(model.NodeServerEdition)
+			}
+
+			if metadata.ResourceData.HasChange("node_storage_quota_in_mb") {
+				properties.NodeStorageQuotaInMb = pointer.To(model.NodeStorageQuotaInMb)
+			}
+
+			if metadata.ResourceData.HasChange("node_vcores") {
+				properties.NodeVCores = pointer.To(model.NodeVCores)
+			}
+
+			if metadata.ResourceData.HasChange("preferred_primary_zone") {
+				properties.PreferredPrimaryZone = pointer.To(model.PreferredPrimaryZone)
+			}
+
+			if metadata.ResourceData.HasChange("shards_on_coordinator_enabled") {
+				properties.EnableShardsOnCoordinator = pointer.To(model.ShardsOnCoordinatorEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("sql_version") {
+				properties.PostgresqlVersion = pointer.To(model.SqlVersion)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = pointer.To(model.Tags)
+			}
+
+			parameters.Properties = &properties
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLClustersClient
+
+			id, err := parse.PostgresqlClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// these aren't returned by the API, so they're retained from the configuration
+			var config CosmosDbPostgreSQLClusterModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state := CosmosDbPostgreSQLClusterModel{
+				Name:                       id.ServerGroupsv2Name,
+				ResourceGroupName:          id.ResourceGroup,
+				AdministratorLoginPassword: config.AdministratorLoginPassword,
+				PointInTimeInUTC:           config.PointInTimeInUTC,
+				SourceLocation:             config.SourceLocation,
+				SourceResourceId:           config.SourceResourceId,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.CitusVersion = pointer.From(props.CitusVersion)
+					state.CoordinatorPublicIPAccessEnabled = pointer.From(props.CoordinatorEnablePublicIPAccess)
+					state.CoordinatorServerEdition = pointer.From(props.CoordinatorServerEdition)
+					state.CoordinatorStorageQuotaInMb = pointer.From(props.CoordinatorStorageQuotaInMb)
+					state.CoordinatorVCoreCount = pointer.From(props.CoordinatorVCores)
+					state.EarliestRestoreTime = pointer.From(props.EarliestRestoreTime)
+					state.HaEnabled = pointer.From(props.EnableHa)
+					state.MaintenanceWindow = flattenCosmosDbPostgreSQLClusterMaintenanceWindow(props.MaintenanceWindow)
+					state.NodeCount = pointer.From(props.NodeCount)
+					state.NodePublicIPAccessEnabled = pointer.From(props.NodeEnablePublicIPAccess)
+					state.NodeServerEdition = pointer.From(props.NodeServerEdition)
+					state.NodeStorageQuotaInMb = pointer.From(props.NodeStorageQuotaInMb)
+					state.NodeVCores = pointer.From(props.NodeVCores)
+					state.PreferredPrimaryZone = pointer.From(props.PreferredPrimaryZone)
+					state.Servers = flattenCosmosDbPostgreSQLClusterServerNames(props.ServerNames)
+					state.ShardsOnCoordinatorEnabled = pointer.From(props.EnableShardsOnCoordinator)
+					state.SqlVersion = pointer.From(props.PostgresqlVersion)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLClustersClient
+
+			id, err := parse.PostgresqlClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbPostgreSQLClusterMaintenanceWindow(input []CosmosDbPostgreSQLClusterMaintenanceWindowModel) *postgresqlhsc.MaintenanceWindow {
+	if len(input) == 0 {
+		return &postgresqlhsc.MaintenanceWindow{
+			CustomWindow: pointer.To("Disabled"),
+		}
+	}
+
+	return &postgresqlhsc.MaintenanceWindow{
+		CustomWindow: pointer.To("Enabled"),
+		DayOfWeek:    pointer.To(input[0].DayOfWeek),
+		StartHour:    pointer.To(input[0].StartHour),
+		StartMinute:  pointer.To(input[0].StartMinute),
+	}
+}
+
+func flattenCosmosDbPostgreSQLClusterMaintenanceWindow(input *postgresqlhsc.MaintenanceWindow) []CosmosDbPostgreSQLClusterMaintenanceWindowModel {
+	if input == nil || pointer.From(input.CustomWindow) != "Enabled" {
+		return []CosmosDbPostgreSQLClusterMaintenanceWindowModel{}
+	}
+
+	return []CosmosDbPostgreSQLClusterMaintenanceWindowModel{
+		{
+			DayOfWeek:   pointer.From(input.DayOfWeek),
+			StartHour:   pointer.From(input.StartHour),
+			StartMinute: pointer.From(input.StartMinute),
+		},
+	}
+}
+
+func flattenCosmosDbPostgreSQLClusterServerNames(input *[]postgresqlhsc.ServerNameItem) []CosmosDbPostgreSQLClusterServerModel {
+	result := make([]CosmosDbPostgreSQLClusterServerModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, CosmosDbPostgreSQLClusterServerModel{
+			Fqdn: pointer.From(v.FullyQualifiedDomainName),
+			Name: pointer.From(v.Name),
+		})
+	}
+
+	return result
+}
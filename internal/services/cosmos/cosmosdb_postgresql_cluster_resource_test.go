@@ -0,0 +1,308 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbPostgreSQLClusterResource struct{}
+
+func TestAccCosmosDbPostgreSQLCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("servers.#").Exists(),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLCluster_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLCluster_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLCluster_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLCluster_pointInTimeRestore(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+		{
+			// a restore point is only available once the source cluster has been running for a while
+			PreConfig: func() { time.Sleep(15 * time.Minute) },
+			Config:    r.pointInTimeRestore(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That("azurerm_cosmosdb_postgresql_cluster.restore").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLCluster_readReplica(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_cluster", "test")
+	r := CosmosDbPostgreSQLClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.readReplica(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_cosmosdb_postgresql_cluster.replica").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_login_password"),
+	})
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PostgresqlClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cosmos.PostgreSQLClustersClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CosmosDbPostgreSQLClusterResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pshsc-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r CosmosDbPostgreSQLClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "test" {
+  name                            = "acctestcluster%d"
+  resource_group_name             = azurerm_resource_group.test.name
+  location                        = azurerm_resource_group.test.location
+  administrator_login_password    = "H@Sh1CoR3!"
+  coordinator_storage_quota_in_mb = 131072
+  coordinator_vcore_count         = 2
+  node_count                      = 0
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbPostgreSQLClusterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "import" {
+  name                            = azurerm_cosmosdb_postgresql_cluster.test.name
+  resource_group_name             = azurerm_cosmosdb_postgresql_cluster.test.resource_group_name
+  location                        = azurerm_cosmosdb_postgresql_cluster.test.location
+  administrator_login_password    = azurerm_cosmosdb_postgresql_cluster.test.administrator_login_password
+  coordinator_storage_quota_in_mb = azurerm_cosmosdb_postgresql_cluster.test.coordinator_storage_quota_in_mb
+  coordinator_vcore_count         = azurerm_cosmosdb_postgresql_cluster.test.coordinator_vcore_count
+  node_count                      = azurerm_cosmosdb_postgresql_cluster.test.node_count
+}
+`, r.basic(data))
+}
+
+func (r CosmosDbPostgreSQLClusterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "test" {
+  name                = "acctestcluster%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  administrator_login_password         = "H@Sh1CoR3!"
+  citus_version                        = "11.1"
+  coordinator_public_ip_access_enabled = false
+  coordinator_server_edition           = "MemoryOptimized"
+  coordinator_storage_quota_in_mb      = 262144
+  coordinator_vcore_count              = 4
+  ha_enabled                           = true
+  node_count                           = 2
+  node_public_ip_access_enabled        = true
+  node_server_edition                  = "GeneralPurpose"
+  node_storage_quota_in_mb             = 262144
+  node_vcores                          = 4
+  preferred_primary_zone               = "1"
+  shards_on_coordinator_enabled        = true
+  sql_version                          = "14"
+
+  maintenance_window {
+    day_of_week  = 1
+    start_hour   = 2
+    start_minute = 30
+  }
+
+  tags = {
+    Env = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbPostgreSQLClusterResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "test" {
+  name                = "acctestcluster%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  administrator_login_password         = "H@Sh1CoR3!2"
+  citus_version                        = "11.2"
+  coordinator_public_ip_access_enabled = true
+  coordinator_server_edition           = "GeneralPurpose"
+  coordinator_storage_quota_in_mb      = 524288
+  coordinator_vcore_count              = 8
+  ha_enabled                           = false
+  node_count                           = 3
+  node_public_ip_access_enabled        = false
+  node_server_edition                  = "MemoryOptimized"
+  node_storage_quota_in_mb             = 524288
+  node_vcores                          = 8
+  preferred_primary_zone               = "2"
+  shards_on_coordinator_enabled        = false
+  sql_version                          = "15"
+
+  maintenance_window {
+    day_of_week  = 0
+    start_hour   = 8
+    start_minute = 0
+  }
+
+  tags = {
+    Env = "Test2"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r CosmosDbPostgreSQLClusterResource) pointInTimeRestore(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "restore" {
+  name                = "acctestcluster%d-restore"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_resource_id  = azurerm_cosmosdb_postgresql_cluster.test.id
+  source_location     = azurerm_cosmosdb_postgresql_cluster.test.location
+
+  point_in_time_in_utc            = azurerm_cosmosdb_postgresql_cluster.test.earliest_restore_time
+  coordinator_storage_quota_in_mb = 131072
+  coordinator_vcore_count         = 2
+  node_count                      = 0
+}
+`, r.basic(data), data.RandomInteger)
+}
+
+func (r CosmosDbPostgreSQLClusterResource) readReplica(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_cluster" "replica" {
+  name                = "acctestcluster%d-replica"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  source_resource_id  = azurerm_cosmosdb_postgresql_cluster.test.id
+  source_location     = azurerm_cosmosdb_postgresql_cluster.test.location
+
+  coordinator_storage_quota_in_mb = 131072
+  coordinator_vcore_count         = 2
+  node_count                      = 0
+}
+`, r.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,216 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/postgresqlhsc"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLFirewallRuleModel struct {
+	Name           string `tfschema:"name"`
+	ClusterId      string `tfschema:"cluster_id"`
+	EndIPAddress   string `tfschema:"end_ip_address"`
+	StartIPAddress string `tfschema:"start_ip_address"`
+}
+
+type CosmosDbPostgreSQLFirewallRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLFirewallRuleResource{}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_firewall_rule"
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLFirewallRuleModel{}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PostgresqlFirewallRuleID
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringLenBetween(1, 128),
+				validation.StringMatch(regexp.MustCompile(`^[-\w.]+$`), "`name` must only contain numbers, characters and `-`, `_`, `.`"),
+			),
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PostgresqlClusterID,
+		},
+
+		"end_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+
+		"start_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLFirewallRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgreSQLFirewallRulesClient
+			clusterId, err := parse.PostgresqlClusterID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPostgresqlFirewallRuleID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ServerGroupsv2Name, model.Name)
+
+			locks.ByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+			defer locks.UnlockByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := postgresqlhsc.FirewallRule{
+				Properties: postgresqlhsc.FirewallRuleProperties{
+					EndIPAddress:   model.EndIPAddress,
+					StartIPAddress: model.StartIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLFirewallRulesClient
+
+			id, err := parse.PostgresqlFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbPostgreSQLFirewallRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+			defer locks.UnlockByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+
+			parameters := postgresqlhsc.FirewallRule{
+				Properties: postgresqlhsc.FirewallRuleProperties{
+					EndIPAddress:   model.EndIPAddress,
+					StartIPAddress: model.StartIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLFirewallRulesClient
+
+			id, err := parse.PostgresqlFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbPostgreSQLFirewallRuleModel{
+				Name:      id.FirewallRuleName,
+				ClusterId: parse.NewPostgresqlClusterID(id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.EndIPAddress = model.Properties.EndIPAddress
+				state.StartIPAddress = model.Properties.StartIPAddress
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLFirewallRulesClient
+
+			id, err := parse.PostgresqlFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+			defer locks.UnlockByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,168 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/postgresqlhsc"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLRoleModel struct {
+	Name      string `tfschema:"name"`
+	ClusterId string `tfschema:"cluster_id"`
+	Password  string `tfschema:"password"`
+}
+
+type CosmosDbPostgreSQLRoleResource struct{}
+
+var _ sdk.Resource = CosmosDbPostgreSQLRoleResource{}
+
+func (r CosmosDbPostgreSQLRoleResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_role"
+}
+
+func (r CosmosDbPostgreSQLRoleResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLRoleModel{}
+}
+
+func (r CosmosDbPostgreSQLRoleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PostgresqlRoleID
+}
+
+func (r CosmosDbPostgreSQLRoleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PostgresqlClusterID,
+		},
+
+		"password": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringLenBetween(8, 256),
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLRoleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbPostgreSQLRoleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLRoleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgreSQLRolesClient
+			clusterId, err := parse.PostgresqlClusterID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewPostgresqlRoleID(clusterId.SubscriptionId, clusterId.ResourceGroup, clusterId.ServerGroupsv2Name, model.Name)
+
+			locks.ByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+			defer locks.UnlockByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := postgresqlhsc.Role{
+				Properties: postgresqlhsc.RoleProperties{
+					Password: model.Password,
+				},
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLRoleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLRolesClient
+
+			id, err := parse.PostgresqlRoleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbPostgreSQLRoleModel{
+				Name:      id.RoleName,
+				ClusterId: parse.NewPostgresqlClusterID(id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name).ID(),
+				// the password isn't returned by the API, so it's retained from the configuration
+				Password: metadata.ResourceData.Get("password").(string),
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLRoleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgreSQLRolesClient
+
+			id, err := parse.PostgresqlRoleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+			defer locks.UnlockByName(id.ServerGroupsv2Name, CosmosDbPostgreSQLClusterResource{}.ResourceType())
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PostgresqlClusterId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ServerGroupsv2Name string
+}
+
+func NewPostgresqlClusterID(subscriptionId, resourceGroup, serverGroupsv2Name string) PostgresqlClusterId {
+	return PostgresqlClusterId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ServerGroupsv2Name: serverGroupsv2Name,
+	}
+}
+
+func (id PostgresqlClusterId) String() string {
+	segments := []string{
+		fmt.Sprintf("Server Groupsv2 Name %q", id.ServerGroupsv2Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Postgresql Cluster", segmentsStr)
+}
+
+func (id PostgresqlClusterId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name)
+}
+
+// PostgresqlClusterID parses a PostgresqlCluster ID into an PostgresqlClusterId struct
+func PostgresqlClusterID(input string) (*PostgresqlClusterId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PostgresqlClusterId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerGroupsv2Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PostgresqlCoordinatorConfigurationId struct {
+	SubscriptionId               string
+	ResourceGroup                string
+	ServerGroupsv2Name           string
+	CoordinatorConfigurationName string
+}
+
+func NewPostgresqlCoordinatorConfigurationID(subscriptionId, resourceGroup, serverGroupsv2Name, coordinatorConfigurationName string) PostgresqlCoordinatorConfigurationId {
+	return PostgresqlCoordinatorConfigurationId{
+		SubscriptionId:               subscriptionId,
+		ResourceGroup:                resourceGroup,
+		ServerGroupsv2Name:           serverGroupsv2Name,
+		CoordinatorConfigurationName: coordinatorConfigurationName,
+	}
+}
+
+func (id PostgresqlCoordinatorConfigurationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Coordinator Configuration Name %q", id.CoordinatorConfigurationName),
+		fmt.Sprintf("Server Groupsv2 Name %q", id.ServerGroupsv2Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Postgresql Coordinator Configuration", segmentsStr)
+}
+
+func (id PostgresqlCoordinatorConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s/coordinatorConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name, id.CoordinatorConfigurationName)
+}
+
+// PostgresqlCoordinatorConfigurationID parses a PostgresqlCoordinatorConfiguration ID into an PostgresqlCoordinatorConfigurationId struct
+func PostgresqlCoordinatorConfigurationID(input string) (*PostgresqlCoordinatorConfigurationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PostgresqlCoordinatorConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerGroupsv2Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+	if resourceId.CoordinatorConfigurationName, err = id.PopSegment("coordinatorConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PostgresqlFirewallRuleId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ServerGroupsv2Name string
+	FirewallRuleName   string
+}
+
+func NewPostgresqlFirewallRuleID(subscriptionId, resourceGroup, serverGroupsv2Name, firewallRuleName string) PostgresqlFirewallRuleId {
+	return PostgresqlFirewallRuleId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ServerGroupsv2Name: serverGroupsv2Name,
+		FirewallRuleName:   firewallRuleName,
+	}
+}
+
+func (id PostgresqlFirewallRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Firewall Rule Name %q", id.FirewallRuleName),
+		fmt.Sprintf("Server Groupsv2 Name %q", id.ServerGroupsv2Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Postgresql Firewall Rule", segmentsStr)
+}
+
+func (id PostgresqlFirewallRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s/firewallRules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name, id.FirewallRuleName)
+}
+
+// PostgresqlFirewallRuleID parses a PostgresqlFirewallRule ID into an PostgresqlFirewallRuleId struct
+func PostgresqlFirewallRuleID(input string) (*PostgresqlFirewallRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PostgresqlFirewallRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerGroupsv2Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+	if resourceId.FirewallRuleName, err = id.PopSegment("firewallRules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
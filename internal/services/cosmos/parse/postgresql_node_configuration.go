@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PostgresqlNodeConfigurationId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	ServerGroupsv2Name    string
+	NodeConfigurationName string
+}
+
+func NewPostgresqlNodeConfigurationID(subscriptionId, resourceGroup, serverGroupsv2Name, nodeConfigurationName string) PostgresqlNodeConfigurationId {
+	return PostgresqlNodeConfigurationId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		ServerGroupsv2Name:    serverGroupsv2Name,
+		NodeConfigurationName: nodeConfigurationName,
+	}
+}
+
+func (id PostgresqlNodeConfigurationId) String() string {
+	segments := []string{
+		fmt.Sprintf("Node Configuration Name %q", id.NodeConfigurationName),
+		fmt.Sprintf("Server Groupsv2 Name %q", id.ServerGroupsv2Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Postgresql Node Configuration", segmentsStr)
+}
+
+func (id PostgresqlNodeConfigurationId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s/nodeConfigurations/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name, id.NodeConfigurationName)
+}
+
+// PostgresqlNodeConfigurationID parses a PostgresqlNodeConfiguration ID into an PostgresqlNodeConfigurationId struct
+func PostgresqlNodeConfigurationID(input string) (*PostgresqlNodeConfigurationId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PostgresqlNodeConfigurationId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerGroupsv2Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+	if resourceId.NodeConfigurationName, err = id.PopSegment("nodeConfigurations"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
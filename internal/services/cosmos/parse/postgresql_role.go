@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type PostgresqlRoleId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	ServerGroupsv2Name string
+	RoleName           string
+}
+
+func NewPostgresqlRoleID(subscriptionId, resourceGroup, serverGroupsv2Name, roleName string) PostgresqlRoleId {
+	return PostgresqlRoleId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		ServerGroupsv2Name: serverGroupsv2Name,
+		RoleName:           roleName,
+	}
+}
+
+func (id PostgresqlRoleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Role Name %q", id.RoleName),
+		fmt.Sprintf("Server Groupsv2 Name %q", id.ServerGroupsv2Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Postgresql Role", segmentsStr)
+}
+
+func (id PostgresqlRoleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/%s/roles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerGroupsv2Name, id.RoleName)
+}
+
+// PostgresqlRoleID parses a PostgresqlRole ID into an PostgresqlRoleId struct
+func PostgresqlRoleID(input string) (*PostgresqlRoleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := PostgresqlRoleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServerGroupsv2Name, err = id.PopSegment("serverGroupsv2"); err != nil {
+		return nil, err
+	}
+	if resourceId.RoleName, err = id.PopSegment("roles"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
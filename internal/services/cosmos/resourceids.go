@@ -19,3 +19,8 @@ package cosmos
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Table -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/tables/table1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CassandraCluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/cassandraClusters/cluster1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=CassandraDatacenter -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/cassandraClusters/cluster1/dataCenters/dc1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PostgresqlCluster -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/cluster1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PostgresqlCoordinatorConfiguration -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/cluster1/coordinatorConfigurations/config1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PostgresqlFirewallRule -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/cluster1/firewallRules/rule1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PostgresqlNodeConfiguration -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/cluster1/nodeConfigurations/config1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=PostgresqlRole -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/serverGroupsv2/cluster1/roles/role1
@@ -0,0 +1,68 @@
+package postgresqlhsc
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type ClustersClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewClustersClientWithBaseURI(api environments.Api) (*ClustersClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "postgresqlhsc", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ClustersClient: %+v", err)
+	}
+
+	return &ClustersClient{
+		Client: client,
+	}, nil
+}
+
+type ConfigurationsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewConfigurationsClientWithBaseURI(api environments.Api) (*ConfigurationsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "postgresqlhsc", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ConfigurationsClient: %+v", err)
+	}
+
+	return &ConfigurationsClient{
+		Client: client,
+	}, nil
+}
+
+type FirewallRulesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewFirewallRulesClientWithBaseURI(api environments.Api) (*FirewallRulesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "postgresqlhsc", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating FirewallRulesClient: %+v", err)
+	}
+
+	return &FirewallRulesClient{
+		Client: client,
+	}, nil
+}
+
+type RolesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewRolesClientWithBaseURI(api environments.Api) (*RolesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "postgresqlhsc", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating RolesClient: %+v", err)
+	}
+
+	return &RolesClient{
+		Client: client,
+	}, nil
+}
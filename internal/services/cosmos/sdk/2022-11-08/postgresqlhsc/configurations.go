@@ -0,0 +1,211 @@
+package postgresqlhsc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+)
+
+type ConfigurationsGetCoordinatorOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ServerConfiguration
+}
+
+// GetCoordinator retrieves the Configuration of the Coordinator of an Azure Cosmos DB for PostgreSQL Cluster
+func (c ConfigurationsClient) GetCoordinator(ctx context.Context, id parse.PostgresqlCoordinatorConfigurationId) (result ConfigurationsGetCoordinatorOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type ConfigurationsUpdateOnCoordinatorOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// UpdateOnCoordinator updates the Configuration of the Coordinator of an Azure Cosmos DB for PostgreSQL Cluster
+func (c ConfigurationsClient) UpdateOnCoordinator(ctx context.Context, id parse.PostgresqlCoordinatorConfigurationId, input ServerConfiguration) (result ConfigurationsUpdateOnCoordinatorOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateOnCoordinatorThenPoll performs UpdateOnCoordinator then polls until it's completed
+func (c ConfigurationsClient) UpdateOnCoordinatorThenPoll(ctx context.Context, id parse.PostgresqlCoordinatorConfigurationId, input ServerConfiguration) error {
+	result, err := c.UpdateOnCoordinator(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateOnCoordinator: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after UpdateOnCoordinator: %+v", err)
+	}
+
+	return nil
+}
+
+type ConfigurationsGetNodeOperationResponse struct {
+	HttpResponse *http.Response
+	OData        *odata.OData
+	Model        *ServerConfiguration
+}
+
+// GetNode retrieves the Configuration of the Nodes of an Azure Cosmos DB for PostgreSQL Cluster
+func (c ConfigurationsClient) GetNode(ctx context.Context, id parse.PostgresqlNodeConfigurationId) (result ConfigurationsGetNodeOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	if err = resp.Unmarshal(&result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+type ConfigurationsUpdateOnNodeOperationResponse struct {
+	Poller       pollers.Poller
+	HttpResponse *http.Response
+	OData        *odata.OData
+}
+
+// UpdateOnNode updates the Configuration of the Nodes of an Azure Cosmos DB for PostgreSQL Cluster
+func (c ConfigurationsClient) UpdateOnNode(ctx context.Context, id parse.PostgresqlNodeConfigurationId, input ServerConfiguration) (result ConfigurationsUpdateOnNodeOperationResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id.ID(),
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.OData = resp.OData
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	result.Poller, err = resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+
+// UpdateOnNodeThenPoll performs UpdateOnNode then polls until it's completed
+func (c ConfigurationsClient) UpdateOnNodeThenPoll(ctx context.Context, id parse.PostgresqlNodeConfigurationId, input ServerConfiguration) error {
+	result, err := c.UpdateOnNode(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateOnNode: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after UpdateOnNode: %+v", err)
+	}
+
+	return nil
+}
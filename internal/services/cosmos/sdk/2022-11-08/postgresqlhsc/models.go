@@ -0,0 +1,122 @@
+package postgresqlhsc
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type Cluster struct {
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ClusterProperties     `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ClusterProperties struct {
+	AdministratorLoginPassword      *string            `json:"administratorLoginPassword,omitempty"`
+	CitusVersion                    *string            `json:"citusVersion,omitempty"`
+	CoordinatorEnablePublicIPAccess *bool              `json:"coordinatorEnablePublicIpAccess,omitempty"`
+	CoordinatorServerEdition        *string            `json:"coordinatorServerEdition,omitempty"`
+	CoordinatorStorageQuotaInMb     *int64             `json:"coordinatorStorageQuotaInMb,omitempty"`
+	CoordinatorVCores               *int64             `json:"coordinatorVCores,omitempty"`
+	EarliestRestoreTime             *string            `json:"earliestRestoreTime,omitempty"`
+	EnableHa                        *bool              `json:"enableHa,omitempty"`
+	EnableShardsOnCoordinator       *bool              `json:"enableShardsOnCoordinator,omitempty"`
+	MaintenanceWindow               *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	NodeCount                       *int64             `json:"nodeCount,omitempty"`
+	NodeEnablePublicIPAccess        *bool              `json:"nodeEnablePublicIpAccess,omitempty"`
+	NodeServerEdition               *string            `json:"nodeServerEdition,omitempty"`
+	NodeStorageQuotaInMb            *int64             `json:"nodeStorageQuotaInMb,omitempty"`
+	NodeVCores                      *int64             `json:"nodeVCores,omitempty"`
+	PointInTimeUTC                  *string            `json:"pointInTimeUTC,omitempty"`
+	PostgresqlVersion               *string            `json:"postgresqlVersion,omitempty"`
+	PreferredPrimaryZone            *string            `json:"preferredPrimaryZone,omitempty"`
+	ProvisioningState               *string            `json:"provisioningState,omitempty"`
+	ServerNames                     *[]ServerNameItem  `json:"serverNames,omitempty"`
+	SourceLocation                  *string            `json:"sourceLocation,omitempty"`
+	SourceResourceId                *string            `json:"sourceResourceId,omitempty"`
+	State                           *string            `json:"state,omitempty"`
+}
+
+type ClusterForUpdate struct {
+	Properties *ClusterPropertiesForUpdate `json:"properties,omitempty"`
+	Tags       *map[string]string          `json:"tags,omitempty"`
+}
+
+type ClusterPropertiesForUpdate struct {
+	AdministratorLoginPassword      *string            `json:"administratorLoginPassword,omitempty"`
+	CitusVersion                    *string            `json:"citusVersion,omitempty"`
+	CoordinatorEnablePublicIPAccess *bool              `json:"coordinatorEnablePublicIpAccess,omitempty"`
+	CoordinatorServerEdition        *string            `json:"coordinatorServerEdition,omitempty"`
+	CoordinatorStorageQuotaInMb     *int64             `json:"coordinatorStorageQuotaInMb,omitempty"`
+	CoordinatorVCores               *int64             `json:"coordinatorVCores,omitempty"`
+	EnableHa                        *bool              `json:"enableHa,omitempty"`
+	EnableShardsOnCoordinator       *bool              `json:"enableShardsOnCoordinator,omitempty"`
+	MaintenanceWindow               *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
+	NodeCount                       *int64             `json:"nodeCount,omitempty"`
+	NodeEnablePublicIPAccess        *bool              `json:"nodeEnablePublicIpAccess,omitempty"`
+	NodeServerEdition               *string            `json:"nodeServerEdition,omitempty"`
+	NodeStorageQuotaInMb            *int64             `json:"nodeStorageQuotaInMb,omitempty"`
+	NodeVCores                      *int64             `json:"nodeVCores,omitempty"`
+	PostgresqlVersion               *string            `json:"postgresqlVersion,omitempty"`
+	PreferredPrimaryZone            *string            `json:"preferredPrimaryZone,omitempty"`
+}
+
+type MaintenanceWindow struct {
+	CustomWindow *string `json:"customWindow,omitempty"`
+	DayOfWeek    *int64  `json:"dayOfWeek,omitempty"`
+	StartHour    *int64  `json:"startHour,omitempty"`
+	StartMinute  *int64  `json:"startMinute,omitempty"`
+}
+
+type ServerNameItem struct {
+	FullyQualifiedDomainName *string `json:"fullyQualifiedDomainName,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+}
+
+type ServerConfiguration struct {
+	Id         *string                        `json:"id,omitempty"`
+	Name       *string                        `json:"name,omitempty"`
+	Properties *ServerConfigurationProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData         `json:"systemData,omitempty"`
+	Type       *string                        `json:"type,omitempty"`
+}
+
+type ServerConfigurationProperties struct {
+	AllowedValues     *string `json:"allowedValues,omitempty"`
+	DataType          *string `json:"dataType,omitempty"`
+	DefaultValue      *string `json:"defaultValue,omitempty"`
+	Description       *string `json:"description,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	RequiresRestart   *bool   `json:"requiresRestart,omitempty"`
+	Value             string  `json:"value"`
+}
+
+type FirewallRule struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties FirewallRuleProperties `json:"properties"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type FirewallRuleProperties struct {
+	EndIPAddress      string  `json:"endIpAddress"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	StartIPAddress    string  `json:"startIpAddress"`
+}
+
+type Role struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties RoleProperties         `json:"properties"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type RoleProperties struct {
+	Password          string  `json:"password"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+}
@@ -0,0 +1,3 @@
+package postgresqlhsc
+
+const defaultApiVersion = "2022-11-08"
@@ -0,0 +1,59 @@
+package validate
+
+import "testing"
+
+func TestPostgresqlClusterName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "ab",
+			Valid: false,
+		},
+		{
+			Value: "abc",
+			Valid: true,
+		},
+		{
+			Value: "acctest-cluster-01",
+			Valid: true,
+		},
+		{
+			Value: "-acctest",
+			Valid: false,
+		},
+		{
+			Value: "acctest-",
+			Valid: false,
+		},
+		{
+			Value: "AccTest",
+			Valid: false,
+		},
+		{
+			Value: "acc_test",
+			Valid: false,
+		},
+		{
+			Value: "abcdefghijabcdefghijabcdefghijabcdefghij",
+			Valid: true,
+		},
+		{
+			Value: "abcdefghijabcdefghijabcdefghijabcdefghijk",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PostgresqlClusterName(tc.Value, "name")
+		valid := len(errors) == 0
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Value)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Azure/azure-sdk-for-go/services/eventgrid/mgmt/2021-12-01/eventgrid" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
 )
 
 type Client struct {
@@ -12,9 +13,17 @@ type Client struct {
 	TopicsClient                        *eventgrid.TopicsClient
 	SystemTopicsClient                  *eventgrid.SystemTopicsClient
 	SystemTopicEventSubscriptionsClient *eventgrid.SystemTopicEventSubscriptionsClient
+
+	NamespacesClient                       *namespaces.NamespacesClient
+	NamespaceClientGroupsClient            *namespaces.ClientGroupsClient
+	NamespaceClientsClient                 *namespaces.ClientsClient
+	NamespacePermissionBindingsClient      *namespaces.PermissionBindingsClient
+	NamespaceTopicsClient                  *namespaces.NamespaceTopicsClient
+	NamespaceTopicEventSubscriptionsClient *namespaces.NamespaceTopicEventSubscriptionsClient
+	NamespaceTopicSpacesClient             *namespaces.TopicSpacesClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	DomainsClient := eventgrid.NewDomainsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&DomainsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -33,6 +42,48 @@ func NewClient(o *common.ClientOptions) *Client {
 	SystemTopicEventSubscriptionsClient := eventgrid.NewSystemTopicEventSubscriptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SystemTopicEventSubscriptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	namespacesClient, err := namespaces.NewNamespacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespacesClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceClientGroupsClient, err := namespaces.NewClientGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespaceClientGroupsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceClientsClient, err := namespaces.NewClientsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespaceClientsClient.Client, o.Authorizers.ResourceManager)
+
+	namespacePermissionBindingsClient, err := namespaces.NewPermissionBindingsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespacePermissionBindingsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicsClient, err := namespaces.NewNamespaceTopicsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespaceTopicsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicEventSubscriptionsClient, err := namespaces.NewNamespaceTopicEventSubscriptionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespaceTopicEventSubscriptionsClient.Client, o.Authorizers.ResourceManager)
+
+	namespaceTopicSpacesClient, err := namespaces.NewTopicSpacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(namespaceTopicSpacesClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		DomainsClient:                       &DomainsClient,
 		EventSubscriptionsClient:            &EventSubscriptionsClient,
@@ -40,5 +91,13 @@ func NewClient(o *common.ClientOptions) *Client {
 		TopicsClient:                        &TopicsClient,
 		SystemTopicsClient:                  &SystemTopicsClient,
 		SystemTopicEventSubscriptionsClient: &SystemTopicEventSubscriptionsClient,
-	}
+
+		NamespacesClient:                       namespacesClient,
+		NamespaceClientGroupsClient:            namespaceClientGroupsClient,
+		NamespaceClientsClient:                 namespaceClientsClient,
+		NamespacePermissionBindingsClient:      namespacePermissionBindingsClient,
+		NamespaceTopicsClient:                  namespaceTopicsClient,
+		NamespaceTopicEventSubscriptionsClient: namespaceTopicEventSubscriptionsClient,
+		NamespaceTopicSpacesClient:             namespaceTopicSpacesClient,
+	}, nil
 }
@@ -0,0 +1,373 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceClientResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceClientResource{}
+
+type EventGridNamespaceClientModel struct {
+	Name                            string                                              `tfschema:"name"`
+	NamespaceId                     string                                              `tfschema:"namespace_id"`
+	AuthenticationName              string                                              `tfschema:"authentication_name"`
+	ClientCertificateAuthentication []EventGridNamespaceClientCertificateAuthentication `tfschema:"client_certificate_authentication"`
+	Attributes                      map[string]string                                   `tfschema:"attributes"`
+	Description                     string                                              `tfschema:"description"`
+	Enabled                         bool                                                `tfschema:"enabled"`
+}
+
+type EventGridNamespaceClientCertificateAuthentication struct {
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientModel{}
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceClientID
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9:._]{3,50}$"),
+				"EventGrid Namespace Client name must be 3 - 50 characters long, contain only letters, numbers, hyphens, colons, periods and underscores.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			certificateAuthentication, err := expandEventGridNamespaceClientCertificateAuthentication(model.ClientCertificateAuthentication)
+			if err != nil {
+				return err
+			}
+
+			state := namespaces.ClientStateEnabled
+			if !model.Enabled {
+				state = namespaces.ClientStateDisabled
+			}
+
+			props := namespaces.ClientProperties{
+				Attributes:                      expandEventGridNamespaceClientAttributes(model.Attributes),
+				ClientCertificateAuthentication: certificateAuthentication,
+				State:                           pointer.To(state),
+			}
+
+			if model.AuthenticationName != "" {
+				props.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, namespaces.Client{Properties: &props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientModel{
+				Name:        id.ClientName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Attributes = flattenEventGridNamespaceClientAttributes(props.Attributes)
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.ClientCertificateAuthentication = flattenEventGridNamespaceClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = props.State == nil || *props.State == namespaces.ClientStateEnabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceClientModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			props := existing.Model.Properties
+
+			if metadata.ResourceData.HasChange("attributes") {
+				props.Attributes = expandEventGridNamespaceClientAttributes(model.Attributes)
+			}
+
+			if metadata.ResourceData.HasChange("authentication_name") {
+				props.AuthenticationName = pointer.To(model.AuthenticationName)
+			}
+
+			if metadata.ResourceData.HasChange("client_certificate_authentication") {
+				certificateAuthentication, err := expandEventGridNamespaceClientCertificateAuthentication(model.ClientCertificateAuthentication)
+				if err != nil {
+					return err
+				}
+				props.ClientCertificateAuthentication = certificateAuthentication
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("enabled") {
+				state := namespaces.ClientStateEnabled
+				if !model.Enabled {
+					state = namespaces.ClientStateDisabled
+				}
+				props.State = pointer.To(state)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, namespaces.Client{Properties: props}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceClientsClient
+
+			id, err := parse.NamespaceClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceClientCertificateAuthentication(input []EventGridNamespaceClientCertificateAuthentication) (*namespaces.ClientCertificateAuthentication, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0]
+	scheme := namespaces.ClientCertificateValidationScheme(v.ValidationScheme)
+
+	if scheme == namespaces.ClientCertificateValidationSchemeThumbprintMatch && len(v.AllowedThumbprints) == 0 {
+		return nil, fmt.Errorf("`allowed_thumbprints` must be specified when `validation_scheme` is %q", scheme)
+	}
+	if scheme != namespaces.ClientCertificateValidationSchemeThumbprintMatch && len(v.AllowedThumbprints) > 0 {
+		return nil, fmt.Errorf("`allowed_thumbprints` can only be specified when `validation_scheme` is %q", namespaces.ClientCertificateValidationSchemeThumbprintMatch)
+	}
+
+	output := namespaces.ClientCertificateAuthentication{
+		ValidationScheme: pointer.To(scheme),
+	}
+
+	if len(v.AllowedThumbprints) > 0 {
+		output.AllowedThumbprints = pointer.To(v.AllowedThumbprints)
+	}
+
+	return &output, nil
+}
+
+func flattenEventGridNamespaceClientCertificateAuthentication(input *namespaces.ClientCertificateAuthentication) []EventGridNamespaceClientCertificateAuthentication {
+	if input == nil {
+		return []EventGridNamespaceClientCertificateAuthentication{}
+	}
+
+	return []EventGridNamespaceClientCertificateAuthentication{
+		{
+			ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+			AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+		},
+	}
+}
+
+func expandEventGridNamespaceClientAttributes(input map[string]string) *map[string]interface{} {
+	attributes := make(map[string]interface{})
+	for k, v := range input {
+		attributes[k] = v
+	}
+
+	return &attributes
+}
+
+func flattenEventGridNamespaceClientAttributes(input *map[string]interface{}) map[string]string {
+	attributes := make(map[string]string)
+	if input == nil {
+		return attributes
+	}
+
+	// only string attributes are supported, array values can't be represented in a map of strings
+	for k, v := range *input {
+		if s, ok := v.(string); ok {
+			attributes[k] = s
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,242 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespacePermissionBindingResource{}
+
+type EventGridNamespacePermissionBindingModel struct {
+	Name            string `tfschema:"name"`
+	NamespaceId     string `tfschema:"namespace_id"`
+	TopicSpaceName  string `tfschema:"topic_space_name"`
+	ClientGroupName string `tfschema:"client_group_name"`
+	Permission      string `tfschema:"permission"`
+	Description     string `tfschema:"description"`
+}
+
+func (r EventGridNamespacePermissionBindingResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_permission_binding"
+}
+
+func (r EventGridNamespacePermissionBindingResource) ModelObject() interface{} {
+	return &EventGridNamespacePermissionBindingModel{}
+}
+
+func (r EventGridNamespacePermissionBindingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespacePermissionBindingID
+}
+
+func (r EventGridNamespacePermissionBindingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Permission Binding name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceID,
+		},
+
+		"topic_space_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"client_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"permission": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(namespaces.PossibleValuesForPermissionType(), false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			var model EventGridNamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			namespaceId, err := parse.NamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespacePermissionBindingID(namespaceId.SubscriptionId, namespaceId.ResourceGroup, namespaceId.Name, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := namespaces.PermissionBindingProperties{
+				TopicSpaceName:  pointer.To(model.TopicSpaceName),
+				ClientGroupName: pointer.To(model.ClientGroupName),
+				Permission:      pointer.To(namespaces.PermissionType(model.Permission)),
+			}
+
+			if model.Description != "" {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, namespaces.PermissionBinding{Properties: &props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespacePermissionBindingModel{
+				Name:        id.PermissionBindingName,
+				NamespaceId: parse.NewNamespaceID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Description = pointer.From(props.Description)
+					state.TopicSpaceName = pointer.From(props.TopicSpaceName)
+					state.ClientGroupName = pointer.From(props.ClientGroupName)
+					state.Permission = string(pointer.From(props.Permission))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespacePermissionBindingModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			props := existing.Model.Properties
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = pointer.To(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("permission") {
+				props.Permission = pointer.To(namespaces.PermissionType(model.Permission))
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, namespaces.PermissionBinding{Properties: props}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespacePermissionBindingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacePermissionBindingsClient
+
+			id, err := parse.NamespacePermissionBindingID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package eventgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type EventGridNamespacePermissionBindingResource struct{}
+
+func TestAccEventGridNamespacePermissionBinding_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccEventGridNamespacePermissionBinding_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_eventgrid_namespace_permission_binding", "test")
+	r := EventGridNamespacePermissionBindingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (EventGridNamespacePermissionBindingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NamespacePermissionBindingID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.EventGrid.NamespacePermissionBindingsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r EventGridNamespacePermissionBindingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name              = "acctest-pb"
+  namespace_id      = azurerm_eventgrid_namespace.test.id
+  topic_space_name  = azurerm_eventgrid_namespace_topic_space.test.name
+  client_group_name = azurerm_eventgrid_namespace_client_group.test.name
+  permission        = "Publisher"
+}
+`, r.template(data))
+}
+
+func (r EventGridNamespacePermissionBindingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "import" {
+  name              = azurerm_eventgrid_namespace_permission_binding.test.name
+  namespace_id      = azurerm_eventgrid_namespace_permission_binding.test.namespace_id
+  topic_space_name  = azurerm_eventgrid_namespace_permission_binding.test.topic_space_name
+  client_group_name = azurerm_eventgrid_namespace_permission_binding.test.client_group_name
+  permission        = azurerm_eventgrid_namespace_permission_binding.test.permission
+}
+`, r.basic(data))
+}
+
+func (r EventGridNamespacePermissionBindingResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_permission_binding" "test" {
+  name              = "acctest-pb"
+  namespace_id      = azurerm_eventgrid_namespace.test.id
+  topic_space_name  = azurerm_eventgrid_namespace_topic_space.test.name
+  client_group_name = azurerm_eventgrid_namespace_client_group.test.name
+  permission        = "Subscriber"
+  description       = "Sensors can subscribe to telemetry"
+}
+`, r.template(data))
+}
+
+func (EventGridNamespacePermissionBindingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventgrid_namespace_topic_space" "test" {
+  name            = "acctest-ts"
+  namespace_id    = azurerm_eventgrid_namespace.test.id
+  topic_templates = ["devices/+/telemetry"]
+}
+
+resource "azurerm_eventgrid_namespace_client_group" "test" {
+  name         = "acctest-cg"
+  namespace_id = azurerm_eventgrid_namespace.test.id
+  query        = "attributes.type = 'sensor'"
+}
+`, EventGridNamespaceResource{}.mqtt(data))
+}
@@ -0,0 +1,509 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceResource{}
+)
+
+type EventGridNamespaceModel struct {
+	Name                       string                                       `tfschema:"name"`
+	ResourceGroupName          string                                       `tfschema:"resource_group_name"`
+	Location                   string                                       `tfschema:"location"`
+	Sku                        string                                       `tfschema:"sku"`
+	Capacity                   int64                                        `tfschema:"capacity"`
+	InboundIPRules             []EventGridNamespaceInboundIPRuleModel       `tfschema:"inbound_ip_rule"`
+	PublicNetworkAccessEnabled bool                                         `tfschema:"public_network_access_enabled"`
+	TopicSpacesConfiguration   []EventGridNamespaceTopicSpacesConfiguration `tfschema:"topic_spaces_configuration"`
+	Tags                       map[string]string                            `tfschema:"tags"`
+}
+
+type EventGridNamespaceInboundIPRuleModel struct {
+	IPMask string `tfschema:"ip_mask"`
+	Action string `tfschema:"action"`
+}
+
+type EventGridNamespaceTopicSpacesConfiguration struct {
+	AlternativeAuthenticationNameSources       []string                                     `tfschema:"alternative_authentication_name_sources"`
+	DynamicRoutingEnrichment                   []EventGridNamespaceDynamicRoutingEnrichment `tfschema:"dynamic_routing_enrichment"`
+	MaximumClientSessionsPerAuthenticationName int64                                        `tfschema:"maximum_client_sessions_per_authentication_name"`
+	MaximumSessionExpiryInHours                int64                                        `tfschema:"maximum_session_expiry_in_hours"`
+	RouteTopicId                               string                                       `tfschema:"route_topic_id"`
+	Hostname                                   string                                       `tfschema:"hostname"`
+}
+
+type EventGridNamespaceDynamicRoutingEnrichment struct {
+	Key   string `tfschema:"key"`
+	Value string `tfschema:"value"`
+}
+
+func (r EventGridNamespaceResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace"
+}
+
+func (r EventGridNamespaceResource) ModelObject() interface{} {
+	return &EventGridNamespaceModel{}
+}
+
+func (r EventGridNamespaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceID
+}
+
+func (r EventGridNamespaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Standard",
+			ValidateFunc: validation.StringInSlice([]string{
+				"Standard",
+			}, false),
+		},
+
+		"capacity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(1, 40),
+		},
+
+		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
+
+		"inbound_ip_rule": eventSubscriptionInboundIPRule(),
+
+		"public_network_access_enabled": eventSubscriptionPublicNetworkAccessEnabled(),
+
+		"topic_spaces_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"alternative_authentication_name_sources": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"ClientCertificateDns",
+								"ClientCertificateEmail",
+								"ClientCertificateIp",
+								"ClientCertificateSubject",
+								"ClientCertificateUri",
+							}, false),
+						},
+					},
+
+					"dynamic_routing_enrichment": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"key": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"maximum_client_sessions_per_authentication_name": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 100),
+					},
+
+					"maximum_session_expiry_in_hours": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntBetween(1, 8),
+					},
+
+					"route_topic_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azure.ValidateResourceID,
+					},
+
+					"hostname": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r EventGridNamespaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewNamespaceID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+			if !model.PublicNetworkAccessEnabled {
+				publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+			}
+
+			payload := namespaces.Namespace{
+				Location: location.Normalize(model.Location),
+				Identity: identityValue,
+				Properties: &namespaces.NamespaceProperties{
+					InboundIPRules:           expandEventGridNamespaceInboundIPRules(model.InboundIPRules),
+					PublicNetworkAccess:      pointer.To(publicNetworkAccess),
+					TopicSpacesConfiguration: expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration),
+				},
+				Sku: &namespaces.NamespaceSku{
+					Name:     pointer.To(model.Sku),
+					Capacity: pointer.To(model.Capacity),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				identityValue, err := identity.FlattenLegacySystemAndUserAssignedMap(model.Identity)
+				if err != nil {
+					return fmt.Errorf("flattening `identity`: %+v", err)
+				}
+
+				if err := metadata.ResourceData.Set("identity", identityValue); err != nil {
+					return fmt.Errorf("setting `identity`: %+v", err)
+				}
+
+				if sku := model.Sku; sku != nil {
+					state.Sku = pointer.From(sku.Name)
+					state.Capacity = pointer.From(sku.Capacity)
+				}
+
+				if props := model.Properties; props != nil {
+					state.InboundIPRules = flattenEventGridNamespaceInboundIPRules(props.InboundIPRules)
+					state.PublicNetworkAccessEnabled = props.PublicNetworkAccess == nil || *props.PublicNetworkAccess == namespaces.PublicNetworkAccessEnabled
+					state.TopicSpacesConfiguration = flattenEventGridNamespaceTopicSpacesConfiguration(props.TopicSpacesConfiguration)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			identityValue, err := identity.ExpandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+			payload.Identity = identityValue
+
+			if metadata.ResourceData.HasChanges("sku", "capacity") {
+				payload.Sku = &namespaces.NamespaceSku{
+					Name:     pointer.To(model.Sku),
+					Capacity: pointer.To(model.Capacity),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("inbound_ip_rule") {
+				payload.Properties.InboundIPRules = expandEventGridNamespaceInboundIPRules(model.InboundIPRules)
+			}
+
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				publicNetworkAccess := namespaces.PublicNetworkAccessEnabled
+				if !model.PublicNetworkAccessEnabled {
+					publicNetworkAccess = namespaces.PublicNetworkAccessDisabled
+				}
+				payload.Properties.PublicNetworkAccess = pointer.To(publicNetworkAccess)
+			}
+
+			if metadata.ResourceData.HasChange("topic_spaces_configuration") {
+				payload.Properties.TopicSpacesConfiguration = expandEventGridNamespaceTopicSpacesConfiguration(model.TopicSpacesConfiguration)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespacesClient
+
+			id, err := parse.NamespaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// the MQTT broker can't be disabled once it's been enabled on a Namespace
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("topic_spaces_configuration"); len(oldVal.([]interface{})) > 0 && len(newVal.([]interface{})) == 0 {
+				if err := metadata.ResourceDiff.ForceNew("topic_spaces_configuration"); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceInboundIPRules(input []EventGridNamespaceInboundIPRuleModel) *[]namespaces.InboundIPRule {
+	if len(input) == 0 {
+		return nil
+	}
+
+	rules := make([]namespaces.InboundIPRule, 0)
+	for _, v := range input {
+		rules = append(rules, namespaces.InboundIPRule{
+			Action: pointer.To(v.Action),
+			IPMask: pointer.To(v.IPMask),
+		})
+	}
+
+	return &rules
+}
+
+func flattenEventGridNamespaceInboundIPRules(input *[]namespaces.InboundIPRule) []EventGridNamespaceInboundIPRuleModel {
+	rules := make([]EventGridNamespaceInboundIPRuleModel, 0)
+	if input == nil {
+		return rules
+	}
+
+	for _, v := range *input {
+		rules = append(rules, EventGridNamespaceInboundIPRuleModel{
+			Action: pointer.From(v.Action),
+			IPMask: pointer.From(v.IPMask),
+		})
+	}
+
+	return rules
+}
+
+func expandEventGridNamespaceTopicSpacesConfiguration(input []EventGridNamespaceTopicSpacesConfiguration) *namespaces.TopicSpacesConfiguration {
+	if len(input) == 0 {
+		return &namespaces.TopicSpacesConfiguration{
+			State: pointer.To(namespaces.TopicSpacesConfigurationStateDisabled),
+		}
+	}
+
+	config := input[0]
+
+	sources := make([]string, 0)
+	sources = append(sources, config.AlternativeAuthenticationNameSources...)
+
+	enrichments := make([]namespaces.DynamicRoutingEnrichment, 0)
+	for _, v := range config.DynamicRoutingEnrichment {
+		enrichments = append(enrichments, namespaces.DynamicRoutingEnrichment{
+			Key:   pointer.To(v.Key),
+			Value: pointer.To(v.Value),
+		})
+	}
+
+	output := namespaces.TopicSpacesConfiguration{
+		State: pointer.To(namespaces.TopicSpacesConfigurationStateEnabled),
+		ClientAuthentication: &namespaces.ClientAuthenticationSettings{
+			AlternativeAuthenticationNameSources: &sources,
+		},
+		MaximumClientSessionsPerAuthenticationName: pointer.To(config.MaximumClientSessionsPerAuthenticationName),
+		MaximumSessionExpiryInHours:                pointer.To(config.MaximumSessionExpiryInHours),
+		RoutingEnrichments: &namespaces.RoutingEnrichments{
+			Dynamic: &enrichments,
+		},
+	}
+
+	if config.RouteTopicId != "" {
+		output.RouteTopicResourceId = pointer.To(config.RouteTopicId)
+	}
+
+	return &output
+}
+
+func flattenEventGridNamespaceTopicSpacesConfiguration(input *namespaces.TopicSpacesConfiguration) []EventGridNamespaceTopicSpacesConfiguration {
+	if input == nil || input.State == nil || *input.State != namespaces.TopicSpacesConfigurationStateEnabled {
+		return []EventGridNamespaceTopicSpacesConfiguration{}
+	}
+
+	sources := make([]string, 0)
+	if auth := input.ClientAuthentication; auth != nil && auth.AlternativeAuthenticationNameSources != nil {
+		sources = append(sources, *auth.AlternativeAuthenticationNameSources...)
+	}
+
+	enrichments := make([]EventGridNamespaceDynamicRoutingEnrichment, 0)
+	if input.RoutingEnrichments != nil && input.RoutingEnrichments.Dynamic != nil {
+		for _, v := range *input.RoutingEnrichments.Dynamic {
+			enrichments = append(enrichments, EventGridNamespaceDynamicRoutingEnrichment{
+				Key:   pointer.From(v.Key),
+				Value: pointer.From(v.Value),
+			})
+		}
+	}
+
+	return []EventGridNamespaceTopicSpacesConfiguration{
+		{
+			AlternativeAuthenticationNameSources:       sources,
+			DynamicRoutingEnrichment:                   enrichments,
+			MaximumClientSessionsPerAuthenticationName: pointer.From(input.MaximumClientSessionsPerAuthenticationName),
+			MaximumSessionExpiryInHours:                pointer.From(input.MaximumSessionExpiryInHours),
+			RouteTopicId:                               pointer.From(input.RouteTopicResourceId),
+			Hostname:                                   pointer.From(input.Hostname),
+		},
+	}
+}
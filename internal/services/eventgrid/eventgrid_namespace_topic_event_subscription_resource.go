@@ -0,0 +1,277 @@
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/sdk/2023-06-01-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/eventgrid/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type EventGridNamespaceTopicEventSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = EventGridNamespaceTopicEventSubscriptionResource{}
+
+type EventGridNamespaceTopicEventSubscriptionModel struct {
+	Name                         string   `tfschema:"name"`
+	NamespaceTopicId             string   `tfschema:"namespace_topic_id"`
+	EventDeliverySchema          string   `tfschema:"event_delivery_schema"`
+	EventTimeToLive              string   `tfschema:"event_time_to_live"`
+	IncludedEventTypes           []string `tfschema:"included_event_types"`
+	MaxDeliveryCount             int64    `tfschema:"max_delivery_count"`
+	ReceiveLockDurationInSeconds int64    `tfschema:"receive_lock_duration_in_seconds"`
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_topic_event_subscription"
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) ModelObject() interface{} {
+	return &EventGridNamespaceTopicEventSubscriptionModel{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NamespaceTopicEventSubscriptionID
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[-a-zA-Z0-9]{3,50}$"),
+				"EventGrid Namespace Topic Event Subscription name must be 3 - 50 characters long, contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"namespace_topic_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NamespaceTopicID,
+		},
+
+		"event_delivery_schema": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			Default:  string(namespaces.DeliverySchemaCloudEventSchemaVOneZero),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(namespaces.DeliverySchemaCloudEventSchemaVOneZero),
+			}, false),
+		},
+
+		"event_time_to_live": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "P7D",
+			ValidateFunc: azValidate.ISO8601Duration,
+		},
+
+		"included_event_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"max_delivery_count": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      10,
+			ValidateFunc: validation.IntBetween(1, 10),
+		},
+
+		"receive_lock_duration_in_seconds": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      60,
+			ValidateFunc: validation.IntBetween(60, 300),
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			topicId, err := parse.NamespaceTopicID(model.NamespaceTopicId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNamespaceTopicEventSubscriptionID(topicId.SubscriptionId, topicId.ResourceGroup, topicId.NamespaceName, topicId.TopicName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := namespaces.SubscriptionProperties{
+				DeliveryConfiguration: expandEventGridNamespaceTopicEventSubscriptionDeliveryConfiguration(model),
+				EventDeliverySchema:   pointer.To(namespaces.DeliverySchema(model.EventDeliverySchema)),
+				FiltersConfiguration: &namespaces.FiltersConfiguration{
+					IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, namespaces.Subscription{Properties: &props}); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := parse.NamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceTopicEventSubscriptionModel{
+				Name:             id.EventSubscriptionName,
+				NamespaceTopicId: parse.NewNamespaceTopicID(id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.EventDeliverySchema = string(pointer.From(props.EventDeliverySchema))
+
+					if filters := props.FiltersConfiguration; filters != nil {
+						state.IncludedEventTypes = pointer.From(filters.IncludedEventTypes)
+					}
+
+					if delivery := props.DeliveryConfiguration; delivery != nil && delivery.Queue != nil {
+						state.EventTimeToLive = pointer.From(delivery.Queue.EventTimeToLive)
+						state.MaxDeliveryCount = pointer.From(delivery.Queue.MaxDeliveryCount)
+						state.ReceiveLockDurationInSeconds = pointer.From(delivery.Queue.ReceiveLockDurationInSeconds)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := parse.NamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model EventGridNamespaceTopicEventSubscriptionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil || existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+			props := existing.Model.Properties
+
+			if metadata.ResourceData.HasChanges("event_time_to_live", "max_delivery_count", "receive_lock_duration_in_seconds") {
+				props.DeliveryConfiguration = expandEventGridNamespaceTopicEventSubscriptionDeliveryConfiguration(model)
+			}
+
+			if metadata.ResourceData.HasChange("included_event_types") {
+				props.FiltersConfiguration = &namespaces.FiltersConfiguration{
+					IncludedEventTypes: pointer.To(model.IncludedEventTypes),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, namespaces.Subscription{Properties: props}); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceTopicEventSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.NamespaceTopicEventSubscriptionsClient
+
+			id, err := parse.NamespaceTopicEventSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandEventGridNamespaceTopicEventSubscriptionDeliveryConfiguration(input EventGridNamespaceTopicEventSubscriptionModel) *namespaces.DeliveryConfiguration {
+	// namespace topics only support pull delivery, where events are held in a queue until they're received by a client
+	return &namespaces.DeliveryConfiguration{
+		DeliveryMode: pointer.To(namespaces.DeliveryModeQueue),
+		Queue: &namespaces.QueueInfo{
+			EventTimeToLive:              pointer.To(input.EventTimeToLive),
+			MaxDeliveryCount:             pointer.To(input.MaxDeliveryCount),
+			ReceiveLockDurationInSeconds: pointer.To(input.ReceiveLockDurationInSeconds),
+		},
+	}
+}
@@ -0,0 +1,81 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespaceTopicEventSubscriptionId struct {
+	SubscriptionId        string
+	ResourceGroup         string
+	NamespaceName         string
+	TopicName             string
+	EventSubscriptionName string
+}
+
+func NewNamespaceTopicEventSubscriptionID(subscriptionId, resourceGroup, namespaceName, topicName, eventSubscriptionName string) NamespaceTopicEventSubscriptionId {
+	return NamespaceTopicEventSubscriptionId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroup:         resourceGroup,
+		NamespaceName:         namespaceName,
+		TopicName:             topicName,
+		EventSubscriptionName: eventSubscriptionName,
+	}
+}
+
+func (id NamespaceTopicEventSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Event Subscription Name %q", id.EventSubscriptionName),
+		fmt.Sprintf("Topic Name %q", id.TopicName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Topic Event Subscription", segmentsStr)
+}
+
+func (id NamespaceTopicEventSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/topics/%s/eventSubscriptions/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.EventSubscriptionName)
+}
+
+// NamespaceTopicEventSubscriptionID parses a NamespaceTopicEventSubscription ID into an NamespaceTopicEventSubscriptionId struct
+func NamespaceTopicEventSubscriptionID(input string) (*NamespaceTopicEventSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NamespaceTopicEventSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+		return nil, err
+	}
+	if resourceId.EventSubscriptionName, err = id.PopSegment("eventSubscriptions"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type NamespaceTopicSpaceId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	NamespaceName  string
+	TopicSpaceName string
+}
+
+func NewNamespaceTopicSpaceID(subscriptionId, resourceGroup, namespaceName, topicSpaceName string) NamespaceTopicSpaceId {
+	return NamespaceTopicSpaceId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		NamespaceName:  namespaceName,
+		TopicSpaceName: topicSpaceName,
+	}
+}
+
+func (id NamespaceTopicSpaceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Topic Space Name %q", id.TopicSpaceName),
+		fmt.Sprintf("Namespace Name %q", id.NamespaceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Namespace Topic Space", segmentsStr)
+}
+
+func (id NamespaceTopicSpaceId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventGrid/namespaces/%s/topicSpaces/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicSpaceName)
+}
+
+// NamespaceTopicSpaceID parses a NamespaceTopicSpace ID into an NamespaceTopicSpaceId struct
+func NamespaceTopicSpaceID(input string) (*NamespaceTopicSpaceId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := NamespaceTopicSpaceId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+		return nil, err
+	}
+	if resourceId.TopicSpaceName, err = id.PopSegment("topicSpaces"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
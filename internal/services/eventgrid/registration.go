@@ -8,6 +8,23 @@ import (
 type Registration struct{}
 
 var _ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+var _ sdk.TypedServiceRegistrationWithAGitHubLabel = Registration{}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		EventGridNamespaceClientGroupResource{},
+		EventGridNamespaceClientResource{},
+		EventGridNamespacePermissionBindingResource{},
+		EventGridNamespaceResource{},
+		EventGridNamespaceTopicEventSubscriptionResource{},
+		EventGridNamespaceTopicResource{},
+		EventGridNamespaceTopicSpaceResource{},
+	}
+}
 
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/event-grid"
@@ -7,3 +7,10 @@ package eventgrid
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SystemTopic -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/systemTopics/systemTopic1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SystemTopicEventSubscription -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/systemTopics/systemTopic1/eventSubscriptions/subscription1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Topic -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/topics/topic1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Namespace -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespaceClient -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/clients/client1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespaceClientGroup -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/clientGroups/clientGroup1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespacePermissionBinding -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/permissionBindings/permissionBinding1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespaceTopic -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/topics/topic1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespaceTopicEventSubscription -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/topics/topic1/eventSubscriptions/subscription1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NamespaceTopicSpace -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/namespaces/namespace1/topicSpaces/topicSpace1
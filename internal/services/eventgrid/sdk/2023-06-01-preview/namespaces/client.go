@@ -0,0 +1,113 @@
+package namespaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type NamespacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespacesClientWithBaseURI(api environments.Api) (*NamespacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespacesClient: %+v", err)
+	}
+
+	return &NamespacesClient{
+		Client: client,
+	}, nil
+}
+
+type ClientGroupsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewClientGroupsClientWithBaseURI(api environments.Api) (*ClientGroupsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ClientGroupsClient: %+v", err)
+	}
+
+	return &ClientGroupsClient{
+		Client: client,
+	}, nil
+}
+
+type ClientsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewClientsClientWithBaseURI(api environments.Api) (*ClientsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ClientsClient: %+v", err)
+	}
+
+	return &ClientsClient{
+		Client: client,
+	}, nil
+}
+
+type PermissionBindingsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewPermissionBindingsClientWithBaseURI(api environments.Api) (*PermissionBindingsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PermissionBindingsClient: %+v", err)
+	}
+
+	return &PermissionBindingsClient{
+		Client: client,
+	}, nil
+}
+
+type NamespaceTopicsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespaceTopicsClientWithBaseURI(api environments.Api) (*NamespaceTopicsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespaceTopicsClient: %+v", err)
+	}
+
+	return &NamespaceTopicsClient{
+		Client: client,
+	}, nil
+}
+
+type NamespaceTopicEventSubscriptionsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewNamespaceTopicEventSubscriptionsClientWithBaseURI(api environments.Api) (*NamespaceTopicEventSubscriptionsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating NamespaceTopicEventSubscriptionsClient: %+v", err)
+	}
+
+	return &NamespaceTopicEventSubscriptionsClient{
+		Client: client,
+	}, nil
+}
+
+type TopicSpacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewTopicSpacesClientWithBaseURI(api environments.Api) (*TopicSpacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "namespaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating TopicSpacesClient: %+v", err)
+	}
+
+	return &TopicSpacesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package namespaces
+
+type ClientCertificateValidationScheme string
+
+const (
+	ClientCertificateValidationSchemeDnsMatchesAuthenticationName     ClientCertificateValidationScheme = "DnsMatchesAuthenticationName"
+	ClientCertificateValidationSchemeEmailMatchesAuthenticationName   ClientCertificateValidationScheme = "EmailMatchesAuthenticationName"
+	ClientCertificateValidationSchemeIPMatchesAuthenticationName      ClientCertificateValidationScheme = "IpMatchesAuthenticationName"
+	ClientCertificateValidationSchemeSubjectMatchesAuthenticationName ClientCertificateValidationScheme = "SubjectMatchesAuthenticationName"
+	ClientCertificateValidationSchemeThumbprintMatch                  ClientCertificateValidationScheme = "ThumbprintMatch"
+	ClientCertificateValidationSchemeUriMatchesAuthenticationName     ClientCertificateValidationScheme = "UriMatchesAuthenticationName"
+)
+
+func PossibleValuesForClientCertificateValidationScheme() []string {
+	return []string{
+		string(ClientCertificateValidationSchemeDnsMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeEmailMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeIPMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeSubjectMatchesAuthenticationName),
+		string(ClientCertificateValidationSchemeThumbprintMatch),
+		string(ClientCertificateValidationSchemeUriMatchesAuthenticationName),
+	}
+}
+
+type ClientState string
+
+const (
+	ClientStateDisabled ClientState = "Disabled"
+	ClientStateEnabled  ClientState = "Enabled"
+)
+
+type DeliveryMode string
+
+const (
+	DeliveryModeQueue DeliveryMode = "Queue"
+)
+
+type DeliverySchema string
+
+const (
+	DeliverySchemaCloudEventSchemaVOneZero DeliverySchema = "CloudEventSchemaV1_0"
+)
+
+type EventInputSchema string
+
+const (
+	EventInputSchemaCloudEventSchemaVOneZero EventInputSchema = "CloudEventSchemaV1_0"
+)
+
+type PermissionType string
+
+const (
+	PermissionTypePublisher  PermissionType = "Publisher"
+	PermissionTypeSubscriber PermissionType = "Subscriber"
+)
+
+func PossibleValuesForPermissionType() []string {
+	return []string{
+		string(PermissionTypePublisher),
+		string(PermissionTypeSubscriber),
+	}
+}
+
+type PublicNetworkAccess string
+
+const (
+	PublicNetworkAccessDisabled PublicNetworkAccess = "Disabled"
+	PublicNetworkAccessEnabled  PublicNetworkAccess = "Enabled"
+)
+
+type PublisherType string
+
+const (
+	PublisherTypeCustom PublisherType = "Custom"
+)
+
+type TopicSpacesConfigurationState string
+
+const (
+	TopicSpacesConfigurationStateDisabled TopicSpacesConfigurationState = "Disabled"
+	TopicSpacesConfigurationStateEnabled  TopicSpacesConfigurationState = "Enabled"
+)
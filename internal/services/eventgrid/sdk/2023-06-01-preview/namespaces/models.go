@@ -0,0 +1,171 @@
+package namespaces
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type Namespace struct {
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *NamespaceProperties                     `json:"properties,omitempty"`
+	Sku        *NamespaceSku                            `json:"sku,omitempty"`
+	SystemData *systemdata.SystemData                   `json:"systemData,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type NamespaceProperties struct {
+	InboundIPRules           *[]InboundIPRule          `json:"inboundIpRules,omitempty"`
+	IsZoneRedundant          *bool                     `json:"isZoneRedundant,omitempty"`
+	MinimumTlsVersionAllowed *string                   `json:"minimumTlsVersionAllowed,omitempty"`
+	ProvisioningState        *string                   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess      `json:"publicNetworkAccess,omitempty"`
+	TopicSpacesConfiguration *TopicSpacesConfiguration `json:"topicSpacesConfiguration,omitempty"`
+}
+
+type NamespaceSku struct {
+	Capacity *int64  `json:"capacity,omitempty"`
+	Name     *string `json:"name,omitempty"`
+}
+
+type InboundIPRule struct {
+	Action *string `json:"action,omitempty"`
+	IPMask *string `json:"ipMask,omitempty"`
+}
+
+type TopicSpacesConfiguration struct {
+	ClientAuthentication                       *ClientAuthenticationSettings  `json:"clientAuthentication,omitempty"`
+	Hostname                                   *string                        `json:"hostname,omitempty"`
+	MaximumClientSessionsPerAuthenticationName *int64                         `json:"maximumClientSessionsPerAuthenticationName,omitempty"`
+	MaximumSessionExpiryInHours                *int64                         `json:"maximumSessionExpiryInHours,omitempty"`
+	RouteTopicResourceId                       *string                        `json:"routeTopicResourceId,omitempty"`
+	RoutingEnrichments                         *RoutingEnrichments            `json:"routingEnrichments,omitempty"`
+	State                                      *TopicSpacesConfigurationState `json:"state,omitempty"`
+}
+
+type ClientAuthenticationSettings struct {
+	AlternativeAuthenticationNameSources *[]string `json:"alternativeAuthenticationNameSources,omitempty"`
+}
+
+type RoutingEnrichments struct {
+	Dynamic *[]DynamicRoutingEnrichment `json:"dynamic,omitempty"`
+}
+
+type DynamicRoutingEnrichment struct {
+	Key   *string `json:"key,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+type Client struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ClientProperties      `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ClientProperties struct {
+	Attributes                      *map[string]interface{}          `json:"attributes,omitempty"`
+	AuthenticationName              *string                          `json:"authenticationName,omitempty"`
+	ClientCertificateAuthentication *ClientCertificateAuthentication `json:"clientCertificateAuthentication,omitempty"`
+	Description                     *string                          `json:"description,omitempty"`
+	ProvisioningState               *string                          `json:"provisioningState,omitempty"`
+	State                           *ClientState                     `json:"state,omitempty"`
+}
+
+type ClientCertificateAuthentication struct {
+	AllowedThumbprints *[]string                          `json:"allowedThumbprints,omitempty"`
+	ValidationScheme   *ClientCertificateValidationScheme `json:"validationScheme,omitempty"`
+}
+
+type ClientGroup struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *ClientGroupProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type ClientGroupProperties struct {
+	Description       *string `json:"description,omitempty"`
+	ProvisioningState *string `json:"provisioningState,omitempty"`
+	Query             *string `json:"query,omitempty"`
+}
+
+type PermissionBinding struct {
+	Id         *string                      `json:"id,omitempty"`
+	Name       *string                      `json:"name,omitempty"`
+	Properties *PermissionBindingProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData       `json:"systemData,omitempty"`
+	Type       *string                      `json:"type,omitempty"`
+}
+
+type PermissionBindingProperties struct {
+	ClientGroupName   *string         `json:"clientGroupName,omitempty"`
+	Description       *string         `json:"description,omitempty"`
+	Permission        *PermissionType `json:"permission,omitempty"`
+	ProvisioningState *string         `json:"provisioningState,omitempty"`
+	TopicSpaceName    *string         `json:"topicSpaceName,omitempty"`
+}
+
+type NamespaceTopic struct {
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *NamespaceTopicProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData    `json:"systemData,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type NamespaceTopicProperties struct {
+	EventRetentionInDays *int64            `json:"eventRetentionInDays,omitempty"`
+	InputSchema          *EventInputSchema `json:"inputSchema,omitempty"`
+	ProvisioningState    *string           `json:"provisioningState,omitempty"`
+	PublisherType        *PublisherType    `json:"publisherType,omitempty"`
+}
+
+type Subscription struct {
+	Id         *string                 `json:"id,omitempty"`
+	Name       *string                 `json:"name,omitempty"`
+	Properties *SubscriptionProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData  `json:"systemData,omitempty"`
+	Type       *string                 `json:"type,omitempty"`
+}
+
+type SubscriptionProperties struct {
+	DeliveryConfiguration *DeliveryConfiguration `json:"deliveryConfiguration,omitempty"`
+	EventDeliverySchema   *DeliverySchema        `json:"eventDeliverySchema,omitempty"`
+	FiltersConfiguration  *FiltersConfiguration  `json:"filtersConfiguration,omitempty"`
+	ProvisioningState     *string                `json:"provisioningState,omitempty"`
+}
+
+type DeliveryConfiguration struct {
+	DeliveryMode *DeliveryMode `json:"deliveryMode,omitempty"`
+	Queue        *QueueInfo    `json:"queue,omitempty"`
+}
+
+type QueueInfo struct {
+	EventTimeToLive              *string `json:"eventTimeToLive,omitempty"`
+	MaxDeliveryCount             *int64  `json:"maxDeliveryCount,omitempty"`
+	ReceiveLockDurationInSeconds *int64  `json:"receiveLockDurationInSeconds,omitempty"`
+}
+
+type FiltersConfiguration struct {
+	IncludedEventTypes *[]string `json:"includedEventTypes,omitempty"`
+}
+
+type TopicSpace struct {
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *TopicSpaceProperties  `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type TopicSpaceProperties struct {
+	Description       *string   `json:"description,omitempty"`
+	ProvisioningState *string   `json:"provisioningState,omitempty"`
+	TopicTemplates    *[]string `json:"topicTemplates,omitempty"`
+}
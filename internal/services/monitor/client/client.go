@@ -9,11 +9,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionruleassociations"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	diagnosticSettingClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
 	diagnosticCategoryClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
 )
 
 type Client struct {
@@ -26,6 +28,7 @@ type Client struct {
 	// alerts management
 	ActionRulesClient             *alertsmanagement.ActionRulesClient
 	AlertProcessingRulesClient    *alertprocessingrules.AlertProcessingRulesClient
+	PrometheusRuleGroupsClient    *prometheusrulegroups.PrometheusRuleGroupsClient
 	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
@@ -33,6 +36,7 @@ type Client struct {
 	ActivityLogsClient                   *classic.ActivityLogsClient
 	ActivityLogAlertsClient              *insights.ActivityLogAlertsClient
 	AlertRulesClient                     *classic.AlertRulesClient
+	AzureMonitorWorkspacesClient         *azuremonitorworkspaces.AzureMonitorWorkspacesClient
 	DataCollectionEndpointsClient        *datacollectionendpoints.DataCollectionEndpointsClient
 	DataCollectionRuleAssociationsClient *datacollectionruleassociations.DataCollectionRuleAssociationsClient
 	DataCollectionRulesClient            *datacollectionrules.DataCollectionRulesClient
@@ -46,7 +50,7 @@ type Client struct {
 	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	AADDiagnosticSettingsClient := aad.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&AADDiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -59,6 +63,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertProcessingRulesClient := alertprocessingrules.NewAlertProcessingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&AlertProcessingRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	PrometheusRuleGroupsClient, err := prometheusrulegroups.NewPrometheusRuleGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(PrometheusRuleGroupsClient.Client, o.Authorizers.ResourceManager)
+
 	SmartDetectorAlertRulesClient := alertsmanagement.NewSmartDetectorAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&SmartDetectorAlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -74,14 +84,23 @@ func NewClient(o *common.ClientOptions) *Client {
 	AlertRulesClient := classic.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&AlertRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	AzureMonitorWorkspacesClient, err := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(AzureMonitorWorkspacesClient.Client, o.Authorizers.ResourceManager)
+
 	DataCollectionEndpointsClient := datacollectionendpoints.NewDataCollectionEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DataCollectionEndpointsClient.Client, o.ResourceManagerAuthorizer)
 
 	DataCollectionRuleAssociationsClient := datacollectionruleassociations.NewDataCollectionRuleAssociationsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DataCollectionRuleAssociationsClient.Client, o.ResourceManagerAuthorizer)
 
-	DataCollectionRulesClient := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.ResourceManagerEndpoint)
-	o.ConfigureClient(&DataCollectionRulesClient.Client, o.ResourceManagerAuthorizer)
+	DataCollectionRulesClient, err := datacollectionrules.NewDataCollectionRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(DataCollectionRulesClient.Client, o.Authorizers.ResourceManager)
 
 	DiagnosticSettingsClient := diagnosticSettingClient.NewDiagnosticSettingsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&DiagnosticSettingsClient.Client, o.ResourceManagerAuthorizer)
@@ -117,16 +136,18 @@ func NewClient(o *common.ClientOptions) *Client {
 		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
 		AlertRulesClient:                     &AlertRulesClient,
 		AlertProcessingRulesClient:           &AlertProcessingRulesClient,
+		AzureMonitorWorkspacesClient:         AzureMonitorWorkspacesClient,
 		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
 		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
-		DataCollectionRulesClient:            &DataCollectionRulesClient,
+		DataCollectionRulesClient:            DataCollectionRulesClient,
 		DiagnosticSettingsClient:             &DiagnosticSettingsClient,
 		DiagnosticSettingsCategoryClient:     &DiagnosticSettingsCategoryClient,
 		LogProfilesClient:                    &LogProfilesClient,
 		MetricAlertsClient:                   &MetricAlertsClient,
 		PrivateLinkScopesClient:              &PrivateLinkScopesClient,
 		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
+		PrometheusRuleGroupsClient:           PrometheusRuleGroupsClient,
 		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
 		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
-	}
+	}, nil
 }
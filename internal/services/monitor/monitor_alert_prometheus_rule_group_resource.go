@@ -0,0 +1,596 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertPrometheusRuleGroupModel struct {
+	Name              string                 `tfschema:"name"`
+	ResourceGroupName string                 `tfschema:"resource_group_name"`
+	Location          string                 `tfschema:"location"`
+	ClusterName       string                 `tfschema:"cluster_name"`
+	Description       string                 `tfschema:"description"`
+	RuleGroupEnabled  bool                   `tfschema:"rule_group_enabled"`
+	Interval          string                 `tfschema:"interval"`
+	Scopes            []string               `tfschema:"scopes"`
+	Rule              []PrometheusRuleModel  `tfschema:"rule"`
+	Tags              map[string]interface{} `tfschema:"tags"`
+}
+
+type PrometheusRuleModel struct {
+	Record          string                          `tfschema:"record"`
+	Alert           string                          `tfschema:"alert"`
+	Enabled         bool                            `tfschema:"enabled"`
+	Expression      string                          `tfschema:"expression"`
+	For             string                          `tfschema:"for"`
+	Severity        int64                           `tfschema:"severity"`
+	Labels          map[string]string               `tfschema:"labels"`
+	Annotations     map[string]string               `tfschema:"annotations"`
+	Action          []PrometheusRuleActionModel     `tfschema:"action"`
+	AlertResolution []PrometheusRuleResolutionModel `tfschema:"alert_resolution"`
+}
+
+type PrometheusRuleActionModel struct {
+	ActionGroupId    string            `tfschema:"action_group_id"`
+	ActionProperties map[string]string `tfschema:"action_properties"`
+}
+
+type PrometheusRuleResolutionModel struct {
+	AutoResolved  bool   `tfschema:"auto_resolved"`
+	TimeToResolve string `tfschema:"time_to_resolve"`
+}
+
+type AlertPrometheusRuleGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+
+var _ sdk.ResourceWithCustomizeDiff = AlertPrometheusRuleGroupResource{}
+
+func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.All(
+				validation.StringIsNotWhiteSpace,
+				validation.StringDoesNotContainAny(":@/#{}%&+*<>?"),
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expression": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+
+					"record": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+
+					"alert": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"for": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: azValidate.ISO8601Duration,
+					},
+
+					"severity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 4),
+					},
+
+					"labels": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"annotations": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"action_group_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validate.ActionGroupID,
+								},
+
+								"action_properties": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+
+					"alert_resolution": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"auto_resolved": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+
+								"time_to_resolve": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: azValidate.ISO8601Duration,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"cluster_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"interval": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: azValidate.ISO8601DurationBetween("PT1M", "PT15M"),
+		},
+
+		"rule_group_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AlertPrometheusRuleGroupResource) ModelObject() interface{} {
+	return &AlertPrometheusRuleGroupModel{}
+}
+
+func (r AlertPrometheusRuleGroupResource) ResourceType() string {
+	return "azurerm_monitor_alert_prometheus_rule_group"
+}
+
+func (r AlertPrometheusRuleGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.PrometheusRuleGroupID
+}
+
+func (r AlertPrometheusRuleGroupResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rules := metadata.ResourceDiff.Get("rule").([]interface{})
+			for i, item := range rules {
+				if item == nil {
+					continue
+				}
+				rule := item.(map[string]interface{})
+
+				// values which are interpolated are unknown at plan time and so can't be checked until apply
+				recordKnown := metadata.ResourceDiff.NewValueKnown(fmt.Sprintf("rule.%d.record", i))
+				alertKnown := metadata.ResourceDiff.NewValueKnown(fmt.Sprintf("rule.%d.alert", i))
+				if !recordKnown || !alertKnown {
+					continue
+				}
+
+				record := rule["record"].(string)
+				alert := rule["alert"].(string)
+				if (record == "") == (alert == "") {
+					return fmt.Errorf("exactly one of `record` or `alert` must be specified for `rule.%d`", i)
+				}
+
+				if record != "" {
+					if rule["for"].(string) != "" {
+						return fmt.Errorf("`for` can only be specified for the alerting rule `rule.%d`", i)
+					}
+					if rule["severity"].(int) != 0 {
+						return fmt.Errorf("`severity` can only be specified for the alerting rule `rule.%d`", i)
+					}
+					if len(rule["action"].([]interface{})) > 0 {
+						return fmt.Errorf("`action` can only be specified for the alerting rule `rule.%d`", i)
+					}
+					if len(rule["alert_resolution"].([]interface{})) > 0 {
+						return fmt.Errorf("`alert_resolution` can only be specified for the alerting rule `rule.%d`", i)
+					}
+					if len(rule["annotations"].(map[string]interface{})) > 0 {
+						return fmt.Errorf("`annotations` can only be specified for the alerting rule `rule.%d`", i)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertPrometheusRuleGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := parse.NewPrometheusRuleGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props := prometheusrulegroups.PrometheusRuleGroupProperties{
+				Enabled: utils.Bool(model.RuleGroupEnabled),
+				Rules:   expandPrometheusRules(model.Rule),
+				Scopes:  model.Scopes,
+			}
+
+			if model.ClusterName != "" {
+				props.ClusterName = utils.String(model.ClusterName)
+			}
+			if model.Description != "" {
+				props.Description = utils.String(model.Description)
+			}
+			if model.Interval != "" {
+				props.Interval = utils.String(model.Interval)
+			}
+
+			input := prometheusrulegroups.PrometheusRuleGroupResource{
+				Location:   location.Normalize(model.Location),
+				Properties: props,
+				Tags:       tags.Expand(model.Tags),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertPrometheusRuleGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			input := *existing.Model
+			props := &input.Properties
+
+			if metadata.ResourceData.HasChange("cluster_name") {
+				props.ClusterName = utils.String(model.ClusterName)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				props.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("rule_group_enabled") {
+				props.Enabled = utils.Bool(model.RuleGroupEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("interval") {
+				props.Interval = utils.String(model.Interval)
+			}
+
+			if metadata.ResourceData.HasChange("rule") {
+				props.Rules = expandPrometheusRules(model.Rule)
+			}
+
+			if metadata.ResourceData.HasChange("scopes") {
+				props.Scopes = model.Scopes
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				input.Tags = tags.Expand(model.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AlertPrometheusRuleGroupModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				props := model.Properties
+				state.ClusterName = pointer.From(props.ClusterName)
+				state.Description = pointer.From(props.Description)
+				state.Interval = pointer.From(props.Interval)
+				state.RuleGroupEnabled = props.Enabled == nil || *props.Enabled
+				state.Rule = flattenPrometheusRules(props.Rules)
+				state.Scopes = props.Scopes
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.PrometheusRuleGroupsClient
+
+			id, err := parse.PrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandPrometheusRules(input []PrometheusRuleModel) []prometheusrulegroups.PrometheusRule {
+	rules := make([]prometheusrulegroups.PrometheusRule, 0)
+	for _, v := range input {
+		rule := prometheusrulegroups.PrometheusRule{
+			Enabled:    utils.Bool(v.Enabled),
+			Expression: v.Expression,
+		}
+
+		if len(v.Labels) > 0 {
+			labels := v.Labels
+			rule.Labels = &labels
+		}
+
+		if v.Record != "" {
+			rule.Record = utils.String(v.Record)
+			rules = append(rules, rule)
+			continue
+		}
+
+		rule.Alert = utils.String(v.Alert)
+		rule.Actions = expandPrometheusRuleActions(v.Action)
+		rule.ResolveConfiguration = expandPrometheusRuleResolveConfiguration(v.AlertResolution)
+		rule.Severity = utils.Int64(v.Severity)
+
+		if v.For != "" {
+			rule.For = utils.String(v.For)
+		}
+
+		if len(v.Annotations) > 0 {
+			annotations := v.Annotations
+			rule.Annotations = &annotations
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func expandPrometheusRuleActions(input []PrometheusRuleActionModel) *[]prometheusrulegroups.PrometheusRuleGroupAction {
+	actions := make([]prometheusrulegroups.PrometheusRuleGroupAction, 0)
+	for _, v := range input {
+		action := prometheusrulegroups.PrometheusRuleGroupAction{
+			ActionGroupId: utils.String(v.ActionGroupId),
+		}
+
+		if len(v.ActionProperties) > 0 {
+			actionProperties := v.ActionProperties
+			action.ActionProperties = &actionProperties
+		}
+
+		actions = append(actions, action)
+	}
+
+	return &actions
+}
+
+func expandPrometheusRuleResolveConfiguration(input []PrometheusRuleResolutionModel) *prometheusrulegroups.PrometheusRuleResolveConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	result := prometheusrulegroups.PrometheusRuleResolveConfiguration{
+		AutoResolved: utils.Bool(v.AutoResolved),
+	}
+	if v.TimeToResolve != "" {
+		result.TimeToResolve = utils.String(v.TimeToResolve)
+	}
+
+	return &result
+}
+
+func flattenPrometheusRules(input []prometheusrulegroups.PrometheusRule) []PrometheusRuleModel {
+	rules := make([]PrometheusRuleModel, 0)
+	for _, v := range input {
+		rule := PrometheusRuleModel{
+			Enabled:    v.Enabled == nil || *v.Enabled,
+			Expression: v.Expression,
+		}
+
+		if v.Record != nil {
+			rule.Record = *v.Record
+		}
+		if v.Alert != nil {
+			rule.Alert = *v.Alert
+		}
+		if v.For != nil {
+			rule.For = *v.For
+		}
+		if v.Severity != nil {
+			rule.Severity = *v.Severity
+		}
+		if v.Labels != nil {
+			rule.Labels = *v.Labels
+		}
+		if v.Annotations != nil {
+			rule.Annotations = *v.Annotations
+		}
+
+		if v.Actions != nil {
+			rule.Action = make([]PrometheusRuleActionModel, 0)
+			for _, a := range *v.Actions {
+				action := PrometheusRuleActionModel{}
+				if a.ActionGroupId != nil {
+					action.ActionGroupId = *a.ActionGroupId
+				}
+				if a.ActionProperties != nil {
+					action.ActionProperties = *a.ActionProperties
+				}
+				rule.Action = append(rule.Action, action)
+			}
+		}
+
+		if resolve := v.ResolveConfiguration; resolve != nil {
+			resolution := PrometheusRuleResolutionModel{}
+			if resolve.AutoResolved != nil {
+				resolution.AutoResolved = *resolve.AutoResolved
+			}
+			if resolve.TimeToResolve != nil {
+				resolution.TimeToResolve = *resolve.TimeToResolve
+			}
+			rule.AlertResolution = []PrometheusRuleResolutionModel{resolution}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
@@ -0,0 +1,258 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorAlertPrometheusRuleGroupResource struct{}
+
+func (r MonitorAlertPrometheusRuleGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.PrometheusRuleGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Monitor.PrometheusRuleGroupsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMonitorAlertPrometheusRuleGroup_recordWithAlertProperties(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_alert_prometheus_rule_group", "test")
+	r := MonitorAlertPrometheusRuleGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.recordWithAlertProperties(data),
+			ExpectError: regexp.MustCompile("`for` can only be specified for the alerting rule"),
+		},
+	})
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rule {
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type))"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "import" {
+  name                = azurerm_monitor_alert_prometheus_rule_group.test.name
+  location            = azurerm_monitor_alert_prometheus_rule_group.test.location
+  resource_group_name = azurerm_monitor_alert_prometheus_rule_group.test.resource_group_name
+  scopes              = azurerm_monitor_alert_prometheus_rule_group.test.scopes
+
+  rule {
+    record     = azurerm_monitor_alert_prometheus_rule_group.test.rule.0.record
+    expression = azurerm_monitor_alert_prometheus_rule_group.test.rule.0.expression
+  }
+}
+`, r.basic(data))
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_action_group" "test" {
+  name                = "acctestActionGroup-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  short_name          = "acctestag"
+}
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  cluster_name        = "acctest-cluster"
+  description         = "This is the description of the following rule group"
+  rule_group_enabled  = false
+  interval            = "PT1M"
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rule {
+    enabled    = false
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type))"
+    labels = {
+      team = "prod"
+    }
+  }
+
+  rule {
+    alert      = "Billing_Processing_Very_Slow"
+    enabled    = true
+    expression = "histogram_quantile(0.99, sum(rate(jobs_duration_seconds_bucket{service=\"billing-processing\"}[5m])) by (job_type)) > 30"
+    for        = "PT5M"
+    severity   = 2
+
+    action {
+      action_group_id = azurerm_monitor_action_group.test.id
+      action_properties = {
+        actionName = "Billing"
+      }
+    }
+
+    alert_resolution {
+      auto_resolved   = true
+      time_to_resolve = "PT10M"
+    }
+
+    annotations = {
+      annotationName = "annotationValue"
+    }
+
+    labels = {
+      team = "prod"
+    }
+  }
+
+  tags = {
+    key = "value"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) recordWithAlertProperties(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_alert_prometheus_rule_group" "test" {
+  name                = "acctest-amprg-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  scopes              = [azurerm_monitor_workspace.test.id]
+
+  rule {
+    record     = "job_type:billing_jobs_duration_seconds:99p5m"
+    expression = "sum(rate(jobs_duration_seconds_count[5m])) by (job_type)"
+    for        = "PT5M"
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MonitorAlertPrometheusRuleGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-monitor-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-mamw-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,729 @@
+package monitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	authRuleParse "github.com/hashicorp/go-azure-sdk/resource-manager/eventhub/2021-11-01/authorizationrulesnamespaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2022-05-01/storageaccounts"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	eventhubValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/eventhub/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// monitorBulkDiagnosticSettingDefaultParallelism is the number of Target Resources configured concurrently by default
+const monitorBulkDiagnosticSettingDefaultParallelism = 10
+
+func resourceMonitorBulkDiagnosticSetting() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMonitorBulkDiagnosticSettingCreate,
+		Read:   resourceMonitorBulkDiagnosticSettingRead,
+		Update: resourceMonitorBulkDiagnosticSettingUpdate,
+		Delete: resourceMonitorBulkDiagnosticSettingDelete,
+
+		// the ID is generated, so the Diagnostic Setting is imported using the format `{resourceId},{resourceId}|{name}`
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, _, err := parseMonitorBulkDiagnosticSettingImportId(id)
+			return err
+		}, importMonitorBulkDiagnosticSetting),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.MonitorDiagnosticSettingName,
+			},
+
+			"target_resource_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+
+			"eventhub_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: eventhubValidate.ValidateEventHubName(),
+			},
+
+			"eventhub_authorization_rule_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: authRuleParse.ValidateAuthorizationRuleID,
+				AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
+			},
+
+			"log_analytics_workspace_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: workspaces.ValidateWorkspaceID,
+				AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: storageaccounts.ValidateStorageAccountID,
+				AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
+			},
+
+			"partner_solution_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateResourceID,
+				AtLeastOneOf: []string{"eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id"},
+			},
+
+			"log_analytics_destination_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Dedicated",
+					"AzureDiagnostics",
+				}, false),
+			},
+
+			"all_categories": func() *pluginsdk.Schema {
+				s := monitorDiagnosticSettingAllCategoriesSchema()
+				s.ConflictsWith = []string{"enabled_log", "metric"}
+				s.AtLeastOneOf = []string{"all_categories", "enabled_log", "metric"}
+				return s
+			}(),
+
+			"enabled_log": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"all_categories"},
+				AtLeastOneOf:  []string{"all_categories", "enabled_log", "metric"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"category": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"category_group": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"metric": {
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"all_categories"},
+				AtLeastOneOf:  []string{"all_categories", "enabled_log", "metric"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"category": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"parallelism": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Default:      monitorBulkDiagnosticSettingDefaultParallelism,
+				ValidateFunc: validation.IntBetween(1, 50),
+			},
+
+			"drifted_target_resource_ids": {
+				Type:     pluginsdk.TypeSet,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		// Target Resources whose Diagnostic Setting has been removed or modified outside of Terraform are surfaced
+		// in the plan, so that they're reconfigured during the next apply
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				if d.Id() == "" {
+					return nil
+				}
+				if drifted := d.Get("drifted_target_resource_ids").(*pluginsdk.Set); drifted.Len() > 0 {
+					return d.SetNew("drifted_target_resource_ids", []string{})
+				}
+				return nil
+			}),
+		),
+	}
+}
+
+func resourceMonitorBulkDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	setting, err := expandMonitorBulkDiagnosticSetting(d)
+	if err != nil {
+		return err
+	}
+
+	targets := *utils.ExpandStringSlice(d.Get("target_resource_ids").(*pluginsdk.Set).List())
+	parallelism := d.Get("parallelism").(int)
+
+	succeeded, err := runMonitorBulkDiagnosticSettingOperation(ctx, targets, parallelism, func(ctx context.Context, targetResourceId string) error {
+		id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, setting.Name)
+		existing, err := client.DiagnosticSettingsClient.Get(ctx, id)
+		if err != nil && !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("checking for presence of existing Monitor Diagnostic Setting: %+v", err)
+		}
+		if !response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("a Monitor Diagnostic Setting named %q already exists - to be managed via Terraform this needs to be removed first", setting.Name)
+		}
+
+		return setting.apply(ctx, client.DiagnosticSettingsClient, client.DiagnosticSettingsCategoryClient, targetResourceId)
+	})
+	if err != nil {
+		// the Diagnostic Settings which were created are removed prior to the ID being set, so that a failed
+		// Create doesn't leave behind Diagnostic Settings which aren't tracked in the state
+		if _, rollbackErr := runMonitorBulkDiagnosticSettingOperation(ctx, succeeded, parallelism, func(ctx context.Context, targetResourceId string) error {
+			return setting.delete(ctx, client.DiagnosticSettingsClient, targetResourceId)
+		}); rollbackErr != nil {
+			return fmt.Errorf("creating Monitor Bulk Diagnostic Setting %q: %+v\n\nremoving the Diagnostic Settings which were created: %+v", setting.Name, err, rollbackErr)
+		}
+
+		return fmt.Errorf("creating Monitor Bulk Diagnostic Setting %q: %+v", setting.Name, err)
+	}
+
+	d.SetId(monitorBulkDiagnosticSettingId(setting.Name))
+
+	return resourceMonitorBulkDiagnosticSettingRead(d, meta)
+}
+
+func resourceMonitorBulkDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	setting, err := expandMonitorBulkDiagnosticSetting(d)
+	if err != nil {
+		return err
+	}
+
+	targets := *utils.ExpandStringSlice(d.Get("target_resource_ids").(*pluginsdk.Set).List())
+	parallelism := d.Get("parallelism").(int)
+
+	// each Target Resource whose Diagnostic Setting has been removed or no longer matches the configuration is
+	// tracked as drifted, which is surfaced as a diff so that it's reconfigured during the next apply
+	var mu sync.Mutex
+	missing := 0
+	succeeded, err := runMonitorBulkDiagnosticSettingOperation(ctx, targets, parallelism, func(ctx context.Context, targetResourceId string) error {
+		exists, matches, err := setting.matches(ctx, client.DiagnosticSettingsClient, client.DiagnosticSettingsCategoryClient, targetResourceId)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			mu.Lock()
+			missing++
+			mu.Unlock()
+		}
+		if !matches {
+			log.Printf("[DEBUG] Monitor Diagnostic Setting %q for Resource %q doesn't match the configuration - marking as drifted", setting.Name, targetResourceId)
+			return errMonitorBulkDiagnosticSettingDrift
+		}
+		return nil
+	})
+	if merr, ok := err.(*multierror.Error); ok {
+		errs := make([]error, 0)
+		for _, e := range merr.Errors {
+			if !errors.Is(e, errMonitorBulkDiagnosticSettingDrift) {
+				errs = append(errs, e)
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("retrieving Monitor Bulk Diagnostic Setting %q: %+v", setting.Name, multierror.Append(nil, errs...))
+		}
+	}
+
+	if missing == len(targets) {
+		log.Printf("[WARN] Monitor Bulk Diagnostic Setting %q no longer exists for any of the Target Resources - removing from state!", setting.Name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", setting.Name)
+	if err := d.Set("target_resource_ids", targets); err != nil {
+		return fmt.Errorf("setting `target_resource_ids`: %+v", err)
+	}
+	if err := d.Set("drifted_target_resource_ids", monitorBulkDiagnosticSettingDifference(targets, succeeded)); err != nil {
+		return fmt.Errorf("setting `drifted_target_resource_ids`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMonitorBulkDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	setting, err := expandMonitorBulkDiagnosticSetting(d)
+	if err != nil {
+		return err
+	}
+
+	parallelism := d.Get("parallelism").(int)
+
+	oldRaw, newRaw := d.GetChange("target_resource_ids")
+	oldTargets := oldRaw.(*pluginsdk.Set)
+	newTargets := newRaw.(*pluginsdk.Set)
+
+	removed := *utils.ExpandStringSlice(oldTargets.Difference(newTargets).List())
+	unchanged := *utils.ExpandStringSlice(oldTargets.Intersection(newTargets).List())
+	toApply := *utils.ExpandStringSlice(newTargets.Difference(oldTargets).List())
+
+	// Target Resources which have drifted since they were last configured need to be reconfigured
+	oldDriftedRaw, _ := d.GetChange("drifted_target_resource_ids")
+	drifted := *utils.ExpandStringSlice(oldDriftedRaw.(*pluginsdk.Set).List())
+	toApply = append(toApply, monitorBulkDiagnosticSettingIntersection(unchanged, drifted)...)
+	unchanged = monitorBulkDiagnosticSettingDifference(unchanged, drifted)
+
+	// when the configuration itself has changed it needs to be applied to every Target Resource
+	if d.HasChanges("eventhub_name", "eventhub_authorization_rule_id", "log_analytics_workspace_id", "storage_account_id", "partner_solution_id", "log_analytics_destination_type", "all_categories", "enabled_log", "metric") {
+		toApply = append(toApply, unchanged...)
+		unchanged = []string{}
+	}
+
+	deleted, deleteErr := runMonitorBulkDiagnosticSettingOperation(ctx, removed, parallelism, func(ctx context.Context, targetResourceId string) error {
+		return setting.delete(ctx, client.DiagnosticSettingsClient, targetResourceId)
+	})
+
+	applied, applyErr := runMonitorBulkDiagnosticSettingOperation(ctx, toApply, parallelism, func(ctx context.Context, targetResourceId string) error {
+		return setting.apply(ctx, client.DiagnosticSettingsClient, client.DiagnosticSettingsCategoryClient, targetResourceId)
+	})
+
+	if deleteErr != nil || applyErr != nil {
+		// Target Resources which failed to be removed are kept in the state so that their removal is retried,
+		// whereas those which failed to be configured are marked as drifted so that they're reconfigured
+		failed := monitorBulkDiagnosticSettingDifference(toApply, applied)
+		tracked := append(unchanged, toApply...)
+		tracked = append(tracked, monitorBulkDiagnosticSettingDifference(removed, deleted)...)
+		if err := d.Set("target_resource_ids", tracked); err != nil {
+			return fmt.Errorf("setting `target_resource_ids`: %+v", err)
+		}
+		if err := d.Set("drifted_target_resource_ids", failed); err != nil {
+			return fmt.Errorf("setting `drifted_target_resource_ids`: %+v", err)
+		}
+
+		return fmt.Errorf("updating Monitor Bulk Diagnostic Setting %q: %+v", setting.Name, multierror.Append(deleteErr, applyErr))
+	}
+
+	return resourceMonitorBulkDiagnosticSettingRead(d, meta)
+}
+
+func resourceMonitorBulkDiagnosticSettingDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Monitor
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	targets := *utils.ExpandStringSlice(d.Get("target_resource_ids").(*pluginsdk.Set).List())
+	parallelism := d.Get("parallelism").(int)
+
+	setting := monitorBulkDiagnosticSetting{
+		Name: name,
+	}
+	if _, err := runMonitorBulkDiagnosticSettingOperation(ctx, targets, parallelism, func(ctx context.Context, targetResourceId string) error {
+		return setting.delete(ctx, client.DiagnosticSettingsClient, targetResourceId)
+	}); err != nil {
+		return fmt.Errorf("deleting Monitor Bulk Diagnostic Setting %q: %+v", name, err)
+	}
+
+	return nil
+}
+
+func importMonitorBulkDiagnosticSetting(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Monitor.DiagnosticSettingsClient
+
+	name, targets, err := parseMonitorBulkDiagnosticSettingImportId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	// the configuration is taken from the first Target Resource, any Target Resources with a
+	// different configuration are then removed from the state during the subsequent Read
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targets[0], name)
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+	props := resp.Model.Properties
+
+	d.Set("name", name)
+	d.Set("target_resource_ids", targets)
+	d.Set("parallelism", monitorBulkDiagnosticSettingDefaultParallelism)
+	d.Set("eventhub_name", props.EventHubName)
+	d.Set("eventhub_authorization_rule_id", props.EventHubAuthorizationRuleId)
+	d.Set("log_analytics_workspace_id", props.WorkspaceId)
+	d.Set("storage_account_id", props.StorageAccountId)
+	d.Set("partner_solution_id", props.MarketplacePartnerId)
+	d.Set("log_analytics_destination_type", props.LogAnalyticsDestinationType)
+
+	enabledLogs := make([]interface{}, 0)
+	if props.Logs != nil {
+		for _, v := range *props.Logs {
+			if !v.Enabled {
+				continue
+			}
+			enabledLogs = append(enabledLogs, map[string]interface{}{
+				"category":       utils.NormalizeNilableString(v.Category),
+				"category_group": utils.NormalizeNilableString(v.CategoryGroup),
+			})
+		}
+	}
+	if err := d.Set("enabled_log", enabledLogs); err != nil {
+		return nil, fmt.Errorf("setting `enabled_log`: %+v", err)
+	}
+
+	metrics := make([]interface{}, 0)
+	if props.Metrics != nil {
+		for _, v := range *props.Metrics {
+			metrics = append(metrics, map[string]interface{}{
+				"category": utils.NormalizeNilableString(v.Category),
+				"enabled":  v.Enabled,
+			})
+		}
+	}
+	if err := d.Set("metric", metrics); err != nil {
+		return nil, fmt.Errorf("setting `metric`: %+v", err)
+	}
+
+	d.SetId(monitorBulkDiagnosticSettingId(name))
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+// monitorBulkDiagnosticSettingId returns the ID of the Bulk Diagnostic Setting, which is derived from the name of the
+// Diagnostic Setting since there's no single resource in Azure representing it
+func monitorBulkDiagnosticSettingId(name string) string {
+	return fmt.Sprintf("bulkDiagnosticSettings/%s", name)
+}
+
+func parseMonitorBulkDiagnosticSettingImportId(input string) (string, []string, error) {
+	v := strings.Split(input, "|")
+	if len(v) != 2 || v[0] == "" || v[1] == "" {
+		return "", nil, fmt.Errorf("expected the Monitor Bulk Diagnostic Setting ID to be in the format `{resourceId},{resourceId}|{name}` but got %q", input)
+	}
+
+	targets := strings.Split(v[0], ",")
+	for _, target := range targets {
+		if _, errs := azure.ValidateResourceID(target, "target_resource_ids"); len(errs) > 0 {
+			return "", nil, fmt.Errorf("parsing the Target Resource ID %q: %+v", target, errs[0])
+		}
+	}
+
+	return v[1], targets, nil
+}
+
+var errMonitorBulkDiagnosticSettingDrift = errors.New("the Monitor Diagnostic Setting doesn't match the configuration")
+
+// runMonitorBulkDiagnosticSettingOperation runs the operation against each of the Target Resources concurrently, returning
+// the Target Resources for which the operation succeeded (sorted) alongside the errors for those which failed
+func runMonitorBulkDiagnosticSettingOperation(ctx context.Context, targets []string, parallelism int, operation func(ctx context.Context, targetResourceId string) error) ([]string, error) {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	var errs *multierror.Error
+	succeeded := make([]string, 0)
+	semaphore := make(chan struct{}, parallelism)
+
+	for _, target := range targets {
+		target := target
+
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			err := operation(ctx, target)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("Resource %q: %w", target, err))
+				return
+			}
+			succeeded = append(succeeded, target)
+		}()
+	}
+	wg.Wait()
+
+	sort.Strings(succeeded)
+	return succeeded, errs.ErrorOrNil()
+}
+
+func monitorBulkDiagnosticSettingIntersection(input []string, include []string) []string {
+	included := make(map[string]struct{})
+	for _, v := range include {
+		included[v] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for _, v := range input {
+		if _, ok := included[v]; ok {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func monitorBulkDiagnosticSettingDifference(input []string, exclude []string) []string {
+	excluded := make(map[string]struct{})
+	for _, v := range exclude {
+		excluded[v] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for _, v := range input {
+		if _, ok := excluded[v]; !ok {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+// monitorBulkDiagnosticSetting is the Diagnostic Setting which is applied to each of the Target Resources
+type monitorBulkDiagnosticSetting struct {
+	Name          string
+	Destinations  diagnosticsettings.DiagnosticSettings
+	AllCategories *monitorDiagnosticSettingAllCategories
+	Logs          []diagnosticsettings.LogSettings
+	Metrics       []diagnosticsettings.MetricSettings
+}
+
+func expandMonitorBulkDiagnosticSetting(d *pluginsdk.ResourceData) (*monitorBulkDiagnosticSetting, error) {
+	setting := monitorBulkDiagnosticSetting{
+		Name:          d.Get("name").(string),
+		AllCategories: expandMonitorDiagnosticSettingAllCategories(d.Get("all_categories").([]interface{})),
+	}
+
+	if v := d.Get("eventhub_authorization_rule_id").(string); v != "" {
+		setting.Destinations.EventHubAuthorizationRuleId = utils.String(v)
+		setting.Destinations.EventHubName = utils.String(d.Get("eventhub_name").(string))
+	}
+
+	if v := d.Get("log_analytics_workspace_id").(string); v != "" {
+		setting.Destinations.WorkspaceId = utils.String(v)
+	}
+
+	if v := d.Get("storage_account_id").(string); v != "" {
+		setting.Destinations.StorageAccountId = utils.String(v)
+	}
+
+	if v := d.Get("partner_solution_id").(string); v != "" {
+		setting.Destinations.MarketplacePartnerId = utils.String(v)
+	}
+
+	if v := d.Get("log_analytics_destination_type").(string); v != "" {
+		setting.Destinations.LogAnalyticsDestinationType = utils.String(v)
+	}
+
+	if setting.AllCategories != nil {
+		return &setting, nil
+	}
+
+	for _, raw := range d.Get("enabled_log").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		category := v["category"].(string)
+		categoryGroup := v["category_group"].(string)
+		if (category == "") == (categoryGroup == "") {
+			return nil, fmt.Errorf("exactly one of `category` or `category_group` must be specified within each `enabled_log` block")
+		}
+
+		output := diagnosticsettings.LogSettings{
+			Enabled: true,
+		}
+		if category != "" {
+			output.Category = utils.String(category)
+		} else {
+			output.CategoryGroup = utils.String(categoryGroup)
+		}
+		setting.Logs = append(setting.Logs, output)
+	}
+
+	for _, raw := range d.Get("metric").(*pluginsdk.Set).List() {
+		v := raw.(map[string]interface{})
+		setting.Metrics = append(setting.Metrics, diagnosticsettings.MetricSettings{
+			Category: utils.String(v["category"].(string)),
+			Enabled:  v["enabled"].(bool),
+		})
+	}
+
+	return &setting, nil
+}
+
+func (s monitorBulkDiagnosticSetting) apply(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, categoriesClient *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string) error {
+	logs := s.Logs
+	metrics := s.Metrics
+	if s.AllCategories != nil {
+		var err error
+		if logs, metrics, err = s.AllCategories.expand(ctx, categoriesClient, targetResourceId); err != nil {
+			return err
+		}
+	}
+
+	properties := s.Destinations
+	properties.Logs = &logs
+	properties.Metrics = &metrics
+
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, s.Name)
+	if _, err := client.CreateOrUpdate(ctx, id, diagnosticsettings.DiagnosticSettingsResource{Properties: &properties}); err != nil {
+		return fmt.Errorf("configuring Monitor Diagnostic Setting: %+v", err)
+	}
+
+	return nil
+}
+
+func (s monitorBulkDiagnosticSetting) delete(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, targetResourceId string) error {
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, s.Name)
+	if resp, err := client.Delete(ctx, id); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting Monitor Diagnostic Setting: %+v", err)
+	}
+	return nil
+}
+
+// matches returns whether the Diagnostic Setting for the Target Resource exists, and if so whether it matches the configuration
+func (s monitorBulkDiagnosticSetting) matches(ctx context.Context, client *diagnosticsettings.DiagnosticSettingsClient, categoriesClient *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string) (bool, bool, error) {
+	id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, s.Name)
+	resp, err := client.Get(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return false, false, nil
+		}
+		return false, false, fmt.Errorf("retrieving Monitor Diagnostic Setting: %+v", err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return true, false, nil
+	}
+	props := resp.Model.Properties
+
+	if !monitorBulkDiagnosticSettingStringMatches(s.Destinations.EventHubAuthorizationRuleId, props.EventHubAuthorizationRuleId) ||
+		!monitorBulkDiagnosticSettingStringMatches(s.Destinations.EventHubName, props.EventHubName) ||
+		!monitorBulkDiagnosticSettingStringMatches(s.Destinations.WorkspaceId, props.WorkspaceId) ||
+		!monitorBulkDiagnosticSettingStringMatches(s.Destinations.StorageAccountId, props.StorageAccountId) ||
+		!monitorBulkDiagnosticSettingStringMatches(s.Destinations.MarketplacePartnerId, props.MarketplacePartnerId) {
+		return true, false, nil
+	}
+
+	// the API defaults this when it's omitted, so it's only compared when it's been specified
+	if s.Destinations.LogAnalyticsDestinationType != nil && !monitorBulkDiagnosticSettingStringMatches(s.Destinations.LogAnalyticsDestinationType, props.LogAnalyticsDestinationType) {
+		return true, false, nil
+	}
+
+	if s.AllCategories != nil {
+		categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, targetResourceId)
+		if err != nil {
+			return true, false, err
+		}
+		return true, categories.coveredBy(props, s.AllCategories.LogCategoryGroup, s.AllCategories.MetricsEnabled), nil
+	}
+
+	expectedLogs := make(map[string]struct{})
+	for _, v := range s.Logs {
+		expectedLogs[monitorBulkDiagnosticSettingLogKey(v)] = struct{}{}
+	}
+	actualLogs := make(map[string]struct{})
+	if props.Logs != nil {
+		for _, v := range *props.Logs {
+			if v.Enabled {
+				actualLogs[monitorBulkDiagnosticSettingLogKey(v)] = struct{}{}
+			}
+		}
+	}
+
+	expectedMetrics := make(map[string]struct{})
+	for _, v := range s.Metrics {
+		if v.Enabled && v.Category != nil {
+			expectedMetrics[strings.ToLower(*v.Category)] = struct{}{}
+		}
+	}
+	actualMetrics := make(map[string]struct{})
+	if props.Metrics != nil {
+		for _, v := range *props.Metrics {
+			if v.Enabled && v.Category != nil {
+				actualMetrics[strings.ToLower(*v.Category)] = struct{}{}
+			}
+		}
+	}
+
+	return true, monitorBulkDiagnosticSettingKeysMatch(expectedLogs, actualLogs) && monitorBulkDiagnosticSettingKeysMatch(expectedMetrics, actualMetrics), nil
+}
+
+func monitorBulkDiagnosticSettingStringMatches(expected *string, actual *string) bool {
+	return strings.EqualFold(utils.NormalizeNilableString(expected), utils.NormalizeNilableString(actual))
+}
+
+func monitorBulkDiagnosticSettingLogKey(input diagnosticsettings.LogSettings) string {
+	if input.CategoryGroup != nil && *input.CategoryGroup != "" {
+		return fmt.Sprintf("group/%s", strings.ToLower(*input.CategoryGroup))
+	}
+	return fmt.Sprintf("category/%s", strings.ToLower(utils.NormalizeNilableString(input.Category)))
+}
+
+func monitorBulkDiagnosticSettingKeysMatch(expected map[string]struct{}, actual map[string]struct{}) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for k := range expected {
+		if _, ok := actual[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
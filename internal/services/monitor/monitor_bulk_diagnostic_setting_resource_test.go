@@ -0,0 +1,155 @@
+package monitor_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MonitorBulkDiagnosticSettingResource struct{}
+
+func TestAccMonitorBulkDiagnosticSetting_allCategories(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_bulk_diagnostic_setting", "test")
+	r := MonitorBulkDiagnosticSettingResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.allCategories(data, 2),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.allCategories(data, 3),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("3"),
+			),
+		},
+		{
+			Config: r.allCategories(data, 1),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccMonitorBulkDiagnosticSetting_enabledLogs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_bulk_diagnostic_setting", "test")
+	r := MonitorBulkDiagnosticSettingResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.enabledLogs(data, "audit"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+		{
+			Config: r.enabledLogs(data, "allLogs"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_resource_ids.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func (MonitorBulkDiagnosticSettingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	name := state.Attributes["name"]
+	for key, targetResourceId := range state.Attributes {
+		if !strings.HasPrefix(key, "target_resource_ids.") || key == "target_resource_ids.#" {
+			continue
+		}
+
+		id := diagnosticsettings.NewScopedDiagnosticSettingID(targetResourceId, name)
+		resp, err := clients.Monitor.DiagnosticSettingsClient.Get(ctx, id)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (MonitorBulkDiagnosticSettingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-LAW-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+  retention_in_days   = 30
+}
+
+resource "azurerm_key_vault" "test" {
+  count               = 3
+  name                = "acctest${count.index}%[3]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+  sku_name            = "standard"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomIntOfLength(15))
+}
+
+func (r MonitorBulkDiagnosticSettingResource) allCategories(data acceptance.TestData, targets int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_bulk_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[2]d"
+  target_resource_ids        = slice(azurerm_key_vault.test.*.id, 0, %[3]d)
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  parallelism                = 2
+
+  all_categories {}
+}
+`, r.template(data), data.RandomInteger, targets)
+}
+
+func (r MonitorBulkDiagnosticSettingResource) enabledLogs(data acceptance.TestData, categoryGroup string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_bulk_diagnostic_setting" "test" {
+  name                       = "acctest-DS-%[2]d"
+  target_resource_ids        = slice(azurerm_key_vault.test.*.id, 0, 2)
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+
+  enabled_log {
+    category_group = "%[3]s"
+  }
+
+  metric {
+    category = "AllMetrics"
+  }
+}
+`, r.template(data), data.RandomInteger, categoryGroup)
+}
@@ -7,11 +7,11 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -101,6 +101,22 @@ func (d DataCollectionRuleDataSource) Attributes() map[string]*pluginsdk.Schema
 							},
 						},
 					},
+					"monitor_account": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*schema.Schema{
+								"monitor_account_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -174,6 +190,41 @@ func (d DataCollectionRuleDataSource) Attributes() map[string]*pluginsdk.Schema
 							},
 						},
 					},
+					"prometheus_forwarder": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+								"streams": {
+									Type:     pluginsdk.TypeList,
+									Computed: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+								"label_include_filter": {
+									Type:     pluginsdk.TypeSet,
+									Computed: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"label": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+											"value": {
+												Type:     pluginsdk.TypeString,
+												Computed: true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"syslog": {
 						Type:     pluginsdk.TypeList,
 						Computed: true,
@@ -262,7 +313,7 @@ func (d DataCollectionRuleDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			id := datacollectionrules.NewDataCollectionRuleID(subscriptionId, state.ResourceGroupName, state.Name)
+			id := parse.NewDataCollectionRuleID(subscriptionId, state.ResourceGroupName, state.Name)
 			metadata.Logger.Infof("retrieving %s", id)
 			resp, err := client.Get(ctx, id)
 			if err != nil {
@@ -272,37 +323,27 @@ func (d DataCollectionRuleDataSource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", id, err)
 			}
 
-			var description, kind, location string
-			var tag map[string]interface{}
-			var dataFlows []DataFlow
-			var dataSources []DataSource
-			var destinations []Destination
+			metadata.SetID(id)
+
+			state = DataCollectionRule{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+			}
 
 			if model := resp.Model; model != nil {
-				kind = flattenDataCollectionRuleKind(model.Kind)
-				location = azure.NormalizeLocation(model.Location)
-				tag = tags.Flatten(model.Tags)
-				if prop := model.Properties; prop != nil {
-					description = flattenStringPtr(prop.Description)
-					dataFlows = flattenDataCollectionRuleDataFlows(prop.DataFlows)
-					dataSources = flattenDataCollectionRuleDataSources(prop.DataSources)
-					destinations = flattenDataCollectionRuleDestinations(prop.Destinations)
+				state.Kind = flattenDataCollectionRuleKind(model.Kind)
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DataFlows = flattenDataCollectionRuleDataFlows(props.DataFlows)
+					state.DataSources = flattenDataCollectionRuleDataSources(props.DataSources)
+					state.Description = flattenStringPtr(props.Description)
+					state.Destinations = flattenDataCollectionRuleDestinations(props.Destinations)
 				}
 			}
 
-			metadata.SetID(id)
-
-			return metadata.Encode(&DataCollectionRule{
-				Name:              id.DataCollectionRuleName,
-				ResourceGroupName: id.ResourceGroupName,
-				DataFlows:         dataFlows,
-				DataSources:       dataSources,
-				Description:       description,
-				Destinations:      destinations,
-				Kind:              kind,
-				Location:          location,
-				Tags:              tag,
-			})
+			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
 	}
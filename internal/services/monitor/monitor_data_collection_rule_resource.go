@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -37,15 +40,17 @@ type DataFlow struct {
 }
 
 type DataSource struct {
-	Extensions          []Extension       `tfschema:"extension"`
-	PerformanceCounters []PerfCounter     `tfschema:"performance_counter"`
-	Syslog              []Syslog          `tfschema:"syslog"`
-	WindowsEventLogs    []WindowsEventLog `tfschema:"windows_event_log"`
+	Extensions          []Extension           `tfschema:"extension"`
+	PerformanceCounters []PerfCounter         `tfschema:"performance_counter"`
+	PrometheusForwarder []PrometheusForwarder `tfschema:"prometheus_forwarder"`
+	Syslog              []Syslog              `tfschema:"syslog"`
+	WindowsEventLogs    []WindowsEventLog     `tfschema:"windows_event_log"`
 }
 
 type Destination struct {
 	AzureMonitorMetrics []AzureMonitorMetric `tfschema:"azure_monitor_metrics"`
 	LogAnalytics        []LogAnalytic        `tfschema:"log_analytics"`
+	MonitorAccount      []MonitorAccount     `tfschema:"monitor_account"`
 }
 
 type Extension struct {
@@ -63,6 +68,17 @@ type PerfCounter struct {
 	Streams                    []string `tfschema:"streams"`
 }
 
+type PrometheusForwarder struct {
+	LabelIncludeFilter []LabelIncludeFilter `tfschema:"label_include_filter"`
+	Name               string               `tfschema:"name"`
+	Streams            []string             `tfschema:"streams"`
+}
+
+type LabelIncludeFilter struct {
+	Label string `tfschema:"label"`
+	Value string `tfschema:"value"`
+}
+
 type Syslog struct {
 	FacilityNames []string `tfschema:"facility_names"`
 	LogLevels     []string `tfschema:"log_levels"`
@@ -85,6 +101,11 @@ type LogAnalytic struct {
 	WorkspaceResourceId string `tfschema:"workspace_resource_id"`
 }
 
+type MonitorAccount struct {
+	MonitorAccountId string `tfschema:"monitor_account_id"`
+	Name             string `tfschema:"name"`
+}
+
 type DataCollectionRuleResource struct{}
 
 func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
@@ -148,7 +169,7 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 								},
 							},
 						},
-						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
 					},
 					"log_analytics": {
 						Type:     pluginsdk.TypeList,
@@ -167,7 +188,26 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 								},
 							},
 						},
-						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
+					},
+					"monitor_account": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*schema.Schema{
+								"monitor_account_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validate.MonitorWorkspaceID,
+								},
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
 					},
 				},
 			},
@@ -256,6 +296,47 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 							},
 						},
 					},
+					"prometheus_forwarder": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"streams": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+										ValidateFunc: validation.StringInSlice(
+											[]string{"Microsoft-PrometheusMetrics"}, false),
+									},
+								},
+								"label_include_filter": {
+									Type:     pluginsdk.TypeSet,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"label": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringInSlice([]string{"microsoft_metrics_include_label"}, false),
+											},
+											"value": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"syslog": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -361,7 +442,7 @@ func (r DataCollectionRuleResource) ResourceType() string {
 }
 
 func (r DataCollectionRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
-	return datacollectionrules.ValidateDataCollectionRuleID
+	return validate.DataCollectionRuleID
 }
 
 func (r DataCollectionRuleResource) ModelObject() interface{} {
@@ -380,7 +461,7 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 			client := metadata.Client.Monitor.DataCollectionRulesClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 
-			id := datacollectionrules.NewDataCollectionRuleID(subscriptionId, state.ResourceGroupName, state.Name)
+			id := parse.NewDataCollectionRuleID(subscriptionId, state.ResourceGroupName, state.Name)
 			metadata.Logger.Infof("creating %s", id)
 
 			existing, err := client.Get(ctx, id)
@@ -398,8 +479,7 @@ func (r DataCollectionRuleResource) Create() sdk.ResourceFunc {
 
 			input := datacollectionrules.DataCollectionRuleResource{
 				Kind:     expandDataCollectionRuleKind(state.Kind),
-				Location: azure.NormalizeLocation(state.Location),
-				Name:     utils.String(state.Name),
+				Location: location.Normalize(state.Location),
 				Properties: &datacollectionrules.DataCollectionRule{
 					DataFlows:    expandDataCollectionRuleDataFlows(state.DataFlows),
 					DataSources:  dataSources,
@@ -424,7 +504,7 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Monitor.DataCollectionRulesClient
-			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			id, err := parse.DataCollectionRuleID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -439,35 +519,25 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
 
-			var description, kind, location string
-			var tag map[string]interface{}
-			var dataFlows []DataFlow
-			var dataSources []DataSource
-			var destinations []Destination
+			state := DataCollectionRule{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+			}
 
 			if model := resp.Model; model != nil {
-				kind = flattenDataCollectionRuleKind(model.Kind)
-				location = azure.NormalizeLocation(model.Location)
-				tag = tags.Flatten(model.Tags)
-				if prop := model.Properties; prop != nil {
-					description = flattenStringPtr(prop.Description)
-					dataFlows = flattenDataCollectionRuleDataFlows(prop.DataFlows)
-					dataSources = flattenDataCollectionRuleDataSources(prop.DataSources)
-					destinations = flattenDataCollectionRuleDestinations(prop.Destinations)
+				state.Kind = flattenDataCollectionRuleKind(model.Kind)
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DataFlows = flattenDataCollectionRuleDataFlows(props.DataFlows)
+					state.DataSources = flattenDataCollectionRuleDataSources(props.DataSources)
+					state.Description = flattenStringPtr(props.Description)
+					state.Destinations = flattenDataCollectionRuleDestinations(props.Destinations)
 				}
 			}
 
-			return metadata.Encode(&DataCollectionRule{
-				Name:              id.DataCollectionRuleName,
-				ResourceGroupName: id.ResourceGroupName,
-				DataFlows:         dataFlows,
-				DataSources:       dataSources,
-				Description:       description,
-				Destinations:      destinations,
-				Kind:              kind,
-				Location:          location,
-				Tags:              tag,
-			})
+			return metadata.Encode(&state)
 		},
 		Timeout: 5 * time.Minute,
 	}
@@ -476,7 +546,7 @@ func (r DataCollectionRuleResource) Read() sdk.ResourceFunc {
 func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			id, err := parse.DataCollectionRuleID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -487,13 +557,11 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
 			}
-			if resp.Model == nil {
-				return fmt.Errorf("unexpected null model of %s", *id)
-			}
-			existing := resp.Model
-			if existing.Properties == nil {
+			if resp.Model == nil || resp.Model.Properties == nil {
 				return fmt.Errorf("unexpected null properties of %s", *id)
 			}
+			existing := *resp.Model
+			props := existing.Properties
 
 			var state DataCollectionRule
 			if err := metadata.Decode(&state); err != nil {
@@ -509,7 +577,7 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 			}
 
 			if metadata.ResourceData.HasChange("data_flow") {
-				existing.Properties.DataFlows = expandDataCollectionRuleDataFlows(state.DataFlows)
+				props.DataFlows = expandDataCollectionRuleDataFlows(state.DataFlows)
 			}
 
 			if metadata.ResourceData.HasChange("data_sources") {
@@ -517,21 +585,18 @@ func (r DataCollectionRuleResource) Update() sdk.ResourceFunc {
 				if err != nil {
 					return err
 				}
-				existing.Properties.DataSources = dataSource
+				props.DataSources = dataSource
 			}
 
 			if metadata.ResourceData.HasChange("description") {
-				existing.Properties.Description = utils.String(state.Description)
+				props.Description = utils.String(state.Description)
 			}
 
 			if metadata.ResourceData.HasChange("destinations") {
-				existing.Properties.Destinations = expandDataCollectionRuleDestinations(state.Destinations)
+				props.Destinations = expandDataCollectionRuleDestinations(state.Destinations)
 			}
 
-			// otherwise Service will return an error: "The resource definition is invalid."
-			existing.SystemData = nil
-
-			if _, err := client.Create(ctx, *id, *existing); err != nil {
+			if _, err := client.Create(ctx, *id, existing); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
 			return nil
@@ -544,7 +609,7 @@ func (r DataCollectionRuleResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.Monitor.DataCollectionRulesClient
-			id, err := datacollectionrules.ParseDataCollectionRuleID(metadata.ResourceData.Id())
+			id, err := parse.DataCollectionRuleID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
 			}
@@ -565,8 +630,15 @@ func expandDataCollectionRuleKind(input string) *datacollectionrules.KnownDataCo
 		return nil
 	}
 
-	result := datacollectionrules.KnownDataCollectionRuleResourceKind(input)
-	return &result
+	return pointer.To(datacollectionrules.KnownDataCollectionRuleResourceKind(input))
+}
+
+func flattenDataCollectionRuleKind(input *datacollectionrules.KnownDataCollectionRuleResourceKind) string {
+	if input == nil {
+		return ""
+	}
+
+	return string(*input)
 }
 
 func stringSlice(input []string) *[]string {
@@ -612,6 +684,7 @@ func expandDataCollectionRuleDataSources(input []DataSource) (*datacollectionrul
 	return &datacollectionrules.DataSourcesSpec{
 		Extensions:          extension,
 		PerformanceCounters: expandDataCollectionRuleDataSourcePerfCounters(input[0].PerformanceCounters),
+		PrometheusForwarder: expandDataCollectionRuleDataSourcePrometheusForwarder(input[0].PrometheusForwarder),
 		Syslog:              expandDataCollectionRuleDataSourceSyslog(input[0].Syslog),
 		WindowsEventLogs:    expandDataCollectionRuleDataSourceWindowsEventLogs(input[0].WindowsEventLogs),
 	}, nil
@@ -685,6 +758,46 @@ func expandDataCollectionRuleDataSourcePerfCounterStreams(input []string) *[]dat
 	return &result
 }
 
+func expandDataCollectionRuleDataSourcePrometheusForwarder(input []PrometheusForwarder) *[]datacollectionrules.PrometheusForwarderDataSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]datacollectionrules.PrometheusForwarderDataSource, 0)
+	for _, v := range input {
+		result = append(result, datacollectionrules.PrometheusForwarderDataSource{
+			LabelIncludeFilter: expandDataCollectionRuleDataSourcePrometheusForwarderLabelIncludeFilter(v.LabelIncludeFilter),
+			Name:               utils.String(v.Name),
+			Streams:            expandDataCollectionRuleDataSourcePrometheusForwarderStreams(v.Streams),
+		})
+	}
+	return &result
+}
+
+func expandDataCollectionRuleDataSourcePrometheusForwarderStreams(input []string) *[]datacollectionrules.KnownPrometheusForwarderDataSourceStreams {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]datacollectionrules.KnownPrometheusForwarderDataSourceStreams, 0)
+	for _, v := range input {
+		result = append(result, datacollectionrules.KnownPrometheusForwarderDataSourceStreams(v))
+	}
+	return &result
+}
+
+func expandDataCollectionRuleDataSourcePrometheusForwarderLabelIncludeFilter(input []LabelIncludeFilter) *map[string]string {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for _, v := range input {
+		result[v.Label] = v.Value
+	}
+	return &result
+}
+
 func expandDataCollectionRuleDataSourceSyslog(input []Syslog) *[]datacollectionrules.SyslogDataSource {
 	if len(input) == 0 {
 		return nil
@@ -777,6 +890,7 @@ func expandDataCollectionRuleDestinations(input []Destination) *datacollectionru
 	return &datacollectionrules.DestinationsSpec{
 		AzureMonitorMetrics: expandDataCollectionRuleDestinationMetrics(input[0].AzureMonitorMetrics),
 		LogAnalytics:        expandDataCollectionRuleDestinationLogAnalytics(input[0].LogAnalytics),
+		MonitoringAccounts:  expandDataCollectionRuleDestinationMonitorAccounts(input[0].MonitorAccount),
 	}
 }
 
@@ -805,11 +919,19 @@ func expandDataCollectionRuleDestinationLogAnalytics(input []LogAnalytic) *[]dat
 	return &result
 }
 
-func flattenDataCollectionRuleKind(input *datacollectionrules.KnownDataCollectionRuleResourceKind) string {
-	if input == nil {
-		return ""
+func expandDataCollectionRuleDestinationMonitorAccounts(input []MonitorAccount) *[]datacollectionrules.MonitoringAccountDestination {
+	if len(input) == 0 {
+		return nil
 	}
-	return string(*input)
+
+	result := make([]datacollectionrules.MonitoringAccountDestination, 0)
+	for _, v := range input {
+		result = append(result, datacollectionrules.MonitoringAccountDestination{
+			AccountResourceId: utils.String(v.MonitorAccountId),
+			Name:              utils.String(v.Name),
+		})
+	}
+	return &result
 }
 
 func flattenStringPtr(input *string) string {
@@ -861,6 +983,7 @@ func flattenDataCollectionRuleDataSources(input *datacollectionrules.DataSources
 	return []DataSource{{
 		Extensions:          flattenDataCollectionRuleDataSourceExtensions(input.Extensions),
 		PerformanceCounters: flattenDataCollectionRuleDataSourcePerfCounters(input.PerformanceCounters),
+		PrometheusForwarder: flattenDataCollectionRuleDataSourcePrometheusForwarder(input.PrometheusForwarder),
 		Syslog:              flattenDataCollectionRuleDataSourceSyslog(input.Syslog),
 		WindowsEventLogs:    flattenDataCollectionRuleWindowsEventLogs(input.WindowsEventLogs),
 	}}
@@ -930,6 +1053,49 @@ func flattenDataCollectionRuleDataSourcePerfCounterStreams(input *[]datacollecti
 	return result
 }
 
+func flattenDataCollectionRuleDataSourcePrometheusForwarder(input *[]datacollectionrules.PrometheusForwarderDataSource) []PrometheusForwarder {
+	if input == nil {
+		return make([]PrometheusForwarder, 0)
+	}
+
+	result := make([]PrometheusForwarder, 0)
+	for _, v := range *input {
+		result = append(result, PrometheusForwarder{
+			LabelIncludeFilter: flattenDataCollectionRuleDataSourcePrometheusForwarderLabelIncludeFilter(v.LabelIncludeFilter),
+			Name:               flattenStringPtr(v.Name),
+			Streams:            flattenDataCollectionRuleDataSourcePrometheusForwarderStreams(v.Streams),
+		})
+	}
+	return result
+}
+
+func flattenDataCollectionRuleDataSourcePrometheusForwarderStreams(input *[]datacollectionrules.KnownPrometheusForwarderDataSourceStreams) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+
+	result := make([]string, 0)
+	for _, v := range *input {
+		result = append(result, string(v))
+	}
+	return result
+}
+
+func flattenDataCollectionRuleDataSourcePrometheusForwarderLabelIncludeFilter(input *map[string]string) []LabelIncludeFilter {
+	if input == nil {
+		return make([]LabelIncludeFilter, 0)
+	}
+
+	result := make([]LabelIncludeFilter, 0)
+	for k, v := range *input {
+		result = append(result, LabelIncludeFilter{
+			Label: k,
+			Value: v,
+		})
+	}
+	return result
+}
+
 func flattenDataCollectionRuleDataSourceSyslog(input *[]datacollectionrules.SyslogDataSource) []Syslog {
 	if input == nil {
 		return make([]Syslog, 0)
@@ -1019,6 +1185,7 @@ func flattenDataCollectionRuleDestinations(input *datacollectionrules.Destinatio
 	return []Destination{{
 		AzureMonitorMetrics: flattenDataCollectionRuleDestinationMetrics(input.AzureMonitorMetrics),
 		LogAnalytics:        flattenDataCollectionRuleDestinationLogAnalytics(input.LogAnalytics),
+		MonitorAccount:      flattenDataCollectionRuleDestinationMonitorAccounts(input.MonitoringAccounts),
 	}}
 }
 
@@ -1046,3 +1213,18 @@ func flattenDataCollectionRuleDestinationLogAnalytics(input *[]datacollectionrul
 	}
 	return result
 }
+
+func flattenDataCollectionRuleDestinationMonitorAccounts(input *[]datacollectionrules.MonitoringAccountDestination) []MonitorAccount {
+	if input == nil {
+		return make([]MonitorAccount, 0)
+	}
+
+	result := make([]MonitorAccount, 0)
+	for _, v := range *input {
+		result = append(result, MonitorAccount{
+			MonitorAccountId: flattenStringPtr(v.AccountResourceId),
+			Name:             flattenStringPtr(v.Name),
+		})
+	}
+	return result
+}
@@ -6,10 +6,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -17,7 +17,7 @@ import (
 type MonitorDataCollectionRuleResource struct{}
 
 func (r MonitorDataCollectionRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := datacollectionrules.ParseDataCollectionRuleID(state.ID)
+	id, err := parse.DataCollectionRuleID(state.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -113,6 +113,21 @@ func TestAccMonitorDataCollectionRule_complete(t *testing.T) {
 	})
 }
 
+func TestAccMonitorDataCollectionRule_prometheusForwarder(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_monitor_data_collection_rule", "test")
+	r := MonitorDataCollectionRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.prometheusForwarder(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MonitorDataCollectionRuleResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -351,6 +366,49 @@ resource "azurerm_monitor_data_collection_rule" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MonitorDataCollectionRuleResource) prometheusForwarder(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_monitor_workspace" "test" {
+  name                = "acctest-mamw-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_monitor_data_collection_rule" "test" {
+  name                = "acctestmdcr-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  kind                = "Linux"
+
+  destinations {
+    monitor_account {
+      monitor_account_id = azurerm_monitor_workspace.test.id
+      name               = "test-destination-monitor-account"
+    }
+  }
+
+  data_flow {
+    streams      = ["Microsoft-PrometheusMetrics"]
+    destinations = ["test-destination-monitor-account"]
+  }
+
+  data_sources {
+    prometheus_forwarder {
+      name    = "test-datasource-prometheus"
+      streams = ["Microsoft-PrometheusMetrics"]
+
+      label_include_filter {
+        label = "microsoft_metrics_include_label"
+        value = "monitor_test"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MonitorDataCollectionRuleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,264 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// monitorDiagnosticCategoryGroupAllLogs is the Category Group containing every Log Category, which (unlike the other
+// Category Groups, which vary by Resource Provider) can be emulated for Resources which don't expose Category Groups
+const monitorDiagnosticCategoryGroupAllLogs = "allLogs"
+
+// monitorDiagnosticCategories are the Diagnostic Categories exposed by a target Resource
+type monitorDiagnosticCategories struct {
+	// Logs are the names of the Log Categories, keyed by the name of the Log Category
+	// and containing the Category Groups which it's a member of
+	Logs    map[string][]string
+	Metrics []string
+}
+
+func listMonitorDiagnosticCategories(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string) (*monitorDiagnosticCategories, error) {
+	// trim off the leading `/` since the List method doesn't expect it
+	resourceId := strings.TrimPrefix(commonids.NewScopeID(targetResourceId).Scope, "/")
+	scopeId, err := commonids.ParseScopeID(resourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", targetResourceId, err)
+	}
+
+	resp, err := client.DiagnosticSettingsCategoryList(ctx, *scopeId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving the Diagnostics Categories for Resource %q: %+v", targetResourceId, err)
+	}
+
+	categories := monitorDiagnosticCategories{
+		Logs:    make(map[string][]string),
+		Metrics: make([]string, 0),
+	}
+	if resp.Model == nil || resp.Model.Value == nil {
+		return &categories, nil
+	}
+
+	for _, v := range *resp.Model.Value {
+		if v.Name == nil || v.Properties == nil || v.Properties.CategoryType == nil {
+			continue
+		}
+
+		switch *v.Properties.CategoryType {
+		case diagnosticsettingscategories.CategoryTypeLogs:
+			groups := make([]string, 0)
+			if v.Properties.CategoryGroups != nil {
+				groups = *v.Properties.CategoryGroups
+			}
+			categories.Logs[*v.Name] = groups
+		case diagnosticsettingscategories.CategoryTypeMetrics:
+			categories.Metrics = append(categories.Metrics, *v.Name)
+		}
+	}
+
+	return &categories, nil
+}
+
+// supportsCategoryGroups returns whether the Category Groups are exposed by the Resource, older Resource Providers
+// only expose the individual Log Categories which therefore need to be enabled individually
+func (c monitorDiagnosticCategories) supportsCategoryGroups() bool {
+	for _, groups := range c.Logs {
+		if len(groups) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// logCategoriesInGroup returns the Log Categories within the specified Category Group
+func (c monitorDiagnosticCategories) logCategoriesInGroup(categoryGroup string) []string {
+	output := make([]string, 0)
+	for category, groups := range c.Logs {
+		if strings.EqualFold(categoryGroup, monitorDiagnosticCategoryGroupAllLogs) && !c.supportsCategoryGroups() {
+			output = append(output, category)
+			continue
+		}
+
+		for _, group := range groups {
+			if strings.EqualFold(group, categoryGroup) {
+				output = append(output, category)
+				break
+			}
+		}
+	}
+	sort.Strings(output)
+	return output
+}
+
+// expandLogs returns the Log Settings which enable the specified Category Group, falling back to the individual
+// Log Categories when Category Groups aren't supported by the Resource
+func (c monitorDiagnosticCategories) expandLogs(categoryGroup string) ([]diagnosticsettings.LogSettings, error) {
+	output := make([]diagnosticsettings.LogSettings, 0)
+	if len(c.Logs) == 0 {
+		return output, nil
+	}
+
+	categories := c.logCategoriesInGroup(categoryGroup)
+	if len(categories) == 0 {
+		return nil, fmt.Errorf("the Category Group %q isn't supported by this Resource", categoryGroup)
+	}
+
+	if c.supportsCategoryGroups() {
+		output = append(output, diagnosticsettings.LogSettings{
+			CategoryGroup: utils.String(categoryGroup),
+			Enabled:       true,
+		})
+		return output, nil
+	}
+
+	for _, category := range categories {
+		output = append(output, diagnosticsettings.LogSettings{
+			Category: utils.String(category),
+			Enabled:  true,
+		})
+	}
+	return output, nil
+}
+
+func (c monitorDiagnosticCategories) expandMetrics(enabled bool) []diagnosticsettings.MetricSettings {
+	output := make([]diagnosticsettings.MetricSettings, 0)
+	for _, category := range c.Metrics {
+		output = append(output, diagnosticsettings.MetricSettings{
+			Category: utils.String(category),
+			Enabled:  enabled,
+		})
+	}
+	return output
+}
+
+// coveredBy returns whether the Log Categories within the Category Group (and optionally all of the Metric Categories)
+// are enabled by the Diagnostic Setting - such that new Categories exposed by the Resource are detected as drift
+func (c monitorDiagnosticCategories) coveredBy(props *diagnosticsettings.DiagnosticSettings, categoryGroup string, metricsEnabled bool) bool {
+	if props == nil {
+		return false
+	}
+
+	enabledLogs := make(map[string]struct{})
+	enabledGroups := make(map[string]struct{})
+	if props.Logs != nil {
+		for _, v := range *props.Logs {
+			if !v.Enabled {
+				continue
+			}
+			if v.Category != nil {
+				enabledLogs[strings.ToLower(*v.Category)] = struct{}{}
+			}
+			if v.CategoryGroup != nil {
+				enabledGroups[strings.ToLower(*v.CategoryGroup)] = struct{}{}
+			}
+		}
+	}
+
+	_, groupEnabled := enabledGroups[strings.ToLower(categoryGroup)]
+	_, allLogsEnabled := enabledGroups[strings.ToLower(monitorDiagnosticCategoryGroupAllLogs)]
+	if !groupEnabled && !allLogsEnabled {
+		for _, category := range c.logCategoriesInGroup(categoryGroup) {
+			if _, ok := enabledLogs[strings.ToLower(category)]; !ok {
+				return false
+			}
+		}
+	}
+
+	if metricsEnabled {
+		enabledMetrics := make(map[string]struct{})
+		if props.Metrics != nil {
+			for _, v := range *props.Metrics {
+				if v.Enabled && v.Category != nil {
+					enabledMetrics[strings.ToLower(*v.Category)] = struct{}{}
+				}
+			}
+		}
+
+		for _, category := range c.Metrics {
+			if _, ok := enabledMetrics[strings.ToLower(category)]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func monitorDiagnosticSettingAllCategoriesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"log_category_group": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					Default:      monitorDiagnosticCategoryGroupAllLogs,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"metrics_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+type monitorDiagnosticSettingAllCategories struct {
+	LogCategoryGroup string
+	MetricsEnabled   bool
+}
+
+func expandMonitorDiagnosticSettingAllCategories(input []interface{}) *monitorDiagnosticSettingAllCategories {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &monitorDiagnosticSettingAllCategories{
+		LogCategoryGroup: v["log_category_group"].(string),
+		MetricsEnabled:   v["metrics_enabled"].(bool),
+	}
+}
+
+// expand returns the Log and Metric Settings for all of the Categories currently exposed by the target Resource
+func (a monitorDiagnosticSettingAllCategories) expand(ctx context.Context, client *diagnosticsettingscategories.DiagnosticSettingsCategoriesClient, targetResourceId string) ([]diagnosticsettings.LogSettings, []diagnosticsettings.MetricSettings, error) {
+	categories, err := listMonitorDiagnosticCategories(ctx, client, targetResourceId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs, err := categories.expandLogs(a.LogCategoryGroup)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expanding the Log Categories for Resource %q: %+v", targetResourceId, err)
+	}
+
+	metrics := categories.expandMetrics(a.MetricsEnabled)
+
+	if len(logs) == 0 && !a.MetricsEnabled {
+		return nil, nil, fmt.Errorf("Resource %q doesn't expose any Log Categories and `metrics_enabled` is `false`", targetResourceId)
+	}
+
+	return logs, metrics, nil
+}
+
+func (a monitorDiagnosticSettingAllCategories) flatten() []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"log_category_group": a.LogCategoryGroup,
+			"metrics_enabled":    a.MetricsEnabled,
+		},
+	}
+}
@@ -104,12 +104,19 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 				}, false),
 			},
 
+			"all_categories": func() *pluginsdk.Schema {
+				s := monitorDiagnosticSettingAllCategoriesSchema()
+				s.ConflictsWith = []string{"enabled_log", "log", "metric"}
+				s.AtLeastOneOf = []string{"all_categories", "enabled_log", "log", "metric"}
+				return s
+			}(),
+
 			"enabled_log": {
 				Type:          pluginsdk.TypeSet,
 				Optional:      true,
 				Computed:      !features.FourPointOhBeta(),
-				ConflictsWith: []string{"log"},
-				AtLeastOneOf:  []string{"enabled_log", "log", "metric"},
+				ConflictsWith: []string{"all_categories", "log"},
+				AtLeastOneOf:  []string{"all_categories", "enabled_log", "log", "metric"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -118,8 +125,9 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 						},
 
 						"category_group": {
-							Type:     pluginsdk.TypeString,
-							Optional: true,
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
 						},
 
 						"retention_policy": {
@@ -147,9 +155,10 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 			},
 
 			"metric": {
-				Type:         pluginsdk.TypeSet,
-				Optional:     true,
-				AtLeastOneOf: []string{"enabled_log", "log", "metric"},
+				Type:          pluginsdk.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"all_categories"},
+				AtLeastOneOf:  []string{"all_categories", "enabled_log", "log", "metric"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"category": {
@@ -190,11 +199,12 @@ func resourceMonitorDiagnosticSetting() *pluginsdk.Resource {
 	}
 	if !features.FourPointOhBeta() {
 		resource.Schema["log"] = &pluginsdk.Schema{
-			Type:         pluginsdk.TypeSet,
-			Optional:     true,
-			Computed:     true,
-			AtLeastOneOf: []string{"enabled_log", "log", "metric"},
-			Deprecated:   "`log` has been superseded by `enabled_log` and will be removed in version 4.0 of the AzureRM Provider.",
+			Type:          pluginsdk.TypeSet,
+			Optional:      true,
+			Computed:      true,
+			AtLeastOneOf:  []string{"all_categories", "enabled_log", "log", "metric"},
+			ConflictsWith: []string{"all_categories"},
+			Deprecated:    "`log` has been superseded by `enabled_log` and will be removed in version 4.0 of the AzureRM Provider.",
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
 					"category": {
@@ -266,7 +276,13 @@ func resourceMonitorDiagnosticSettingCreate(d *pluginsdk.ResourceData, meta inte
 
 	var logs []diagnosticsettings.LogSettings
 	hasEnabledLogs := false
-	if !features.FourPointOhBeta() {
+	if allCategories := expandMonitorDiagnosticSettingAllCategories(d.Get("all_categories").([]interface{})); allCategories != nil {
+		categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+		if logs, metrics, err = allCategories.expand(ctx, categoriesClient, id.ResourceUri); err != nil {
+			return fmt.Errorf("creating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		}
+		hasEnabledLogs = len(logs) > 0
+	} else if !features.FourPointOhBeta() {
 		logsRaw, ok := d.GetOk("log")
 		if ok && len(logsRaw.(*pluginsdk.Set).List()) > 0 {
 			logs = expandMonitorDiagnosticsSettingsLogs(logsRaw.(*pluginsdk.Set).List())
@@ -369,7 +385,15 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 	var logs []diagnosticsettings.LogSettings
 	hasEnabledLogs := false
 	logChanged := false
-	if !features.FourPointOhBeta() {
+	allCategories := expandMonitorDiagnosticSettingAllCategories(d.Get("all_categories").([]interface{}))
+	if allCategories != nil {
+		// the Categories exposed by the Resource can change over time, so these are always re-evaluated
+		categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+		if logs, metrics, err = allCategories.expand(ctx, categoriesClient, id.ResourceUri); err != nil {
+			return fmt.Errorf("updating Monitor Diagnostics Setting %q for Resource %q: %+v", id.DiagnosticSettingName, id.ResourceUri, err)
+		}
+		hasEnabledLogs = len(logs) > 0
+	} else if !features.FourPointOhBeta() {
 		if d.HasChange("log") {
 			logChanged = true
 			logsRaw := d.Get("log").(*pluginsdk.Set).List()
@@ -383,18 +407,20 @@ func resourceMonitorDiagnosticSettingUpdate(d *pluginsdk.ResourceData, meta inte
 		}
 	}
 
-	if d.HasChange("enabled_log") {
-		enabledLogs := d.Get("enabled_log").(*pluginsdk.Set).List()
-		if len(enabledLogs) > 0 {
-			logs = expandMonitorDiagnosticsSettingsEnabledLogs(enabledLogs)
-			hasEnabledLogs = true
-		}
-	} else if !logChanged && existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.Logs != nil {
-		logs = *existing.Model.Properties.Logs
-		for _, v := range logs {
-			if v.Enabled {
+	if allCategories == nil {
+		if d.HasChange("enabled_log") {
+			enabledLogs := d.Get("enabled_log").(*pluginsdk.Set).List()
+			if len(enabledLogs) > 0 {
+				logs = expandMonitorDiagnosticsSettingsEnabledLogs(enabledLogs)
 				hasEnabledLogs = true
 			}
+		} else if !logChanged && existing.Model != nil && existing.Model.Properties != nil && existing.Model.Properties.Logs != nil {
+			logs = *existing.Model.Properties.Logs
+			for _, v := range logs {
+				if v.Enabled {
+					hasEnabledLogs = true
+				}
+			}
 		}
 	}
 
@@ -524,19 +550,56 @@ func resourceMonitorDiagnosticSettingRead(d *pluginsdk.ResourceData, meta interf
 			}
 			d.Set("log_analytics_destination_type", logAnalyticsDestinationType)
 
-			enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
-			if err = d.Set("enabled_log", enabledLogs); err != nil {
-				return fmt.Errorf("setting `enabled_log`: %+v", err)
-			}
+			// when all of the Categories are enabled, the individual Categories aren't tracked - instead the Categories
+			// exposed by the Resource are checked, so that any which have since been added surface as a diff
+			allCategories := expandMonitorDiagnosticSettingAllCategories(d.Get("all_categories").([]interface{}))
+			if allCategories != nil {
+				categoriesClient := meta.(*clients.Client).Monitor.DiagnosticSettingsCategoryClient
+				categories, err := listMonitorDiagnosticCategories(ctx, categoriesClient, id.ResourceUri)
+				if err != nil {
+					return err
+				}
 
-			if !features.FourPointOhBeta() {
-				if err = d.Set("log", flattenMonitorDiagnosticLogs(resp.Model.Properties.Logs)); err != nil {
-					return fmt.Errorf("setting `log`: %+v", err)
+				if !categories.coveredBy(props, allCategories.LogCategoryGroup, allCategories.MetricsEnabled) {
+					log.Printf("[DEBUG] Monitor Diagnostics Setting %q for Resource %q doesn't cover all of the Categories exposed by the Resource", id.DiagnosticSettingName, id.ResourceUri)
+					allCategories = nil
 				}
 			}
 
-			if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Model.Properties.Metrics)); err != nil {
-				return fmt.Errorf("setting `metric`: %+v", err)
+			if allCategories != nil {
+				if err := d.Set("all_categories", allCategories.flatten()); err != nil {
+					return fmt.Errorf("setting `all_categories`: %+v", err)
+				}
+				if err := d.Set("enabled_log", []interface{}{}); err != nil {
+					return fmt.Errorf("setting `enabled_log`: %+v", err)
+				}
+				if !features.FourPointOhBeta() {
+					if err := d.Set("log", []interface{}{}); err != nil {
+						return fmt.Errorf("setting `log`: %+v", err)
+					}
+				}
+				if err := d.Set("metric", []interface{}{}); err != nil {
+					return fmt.Errorf("setting `metric`: %+v", err)
+				}
+			} else {
+				if err := d.Set("all_categories", []interface{}{}); err != nil {
+					return fmt.Errorf("setting `all_categories`: %+v", err)
+				}
+
+				enabledLogs := flattenMonitorDiagnosticEnabledLogs(resp.Model.Properties.Logs)
+				if err = d.Set("enabled_log", enabledLogs); err != nil {
+					return fmt.Errorf("setting `enabled_log`: %+v", err)
+				}
+
+				if !features.FourPointOhBeta() {
+					if err = d.Set("log", flattenMonitorDiagnosticLogs(resp.Model.Properties.Logs)); err != nil {
+						return fmt.Errorf("setting `log`: %+v", err)
+					}
+				}
+
+				if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Model.Properties.Metrics)); err != nil {
+					return fmt.Errorf("setting `metric`: %+v", err)
+				}
 			}
 		}
 	}
@@ -0,0 +1,259 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type Workspace struct {
+	Name                            string                 `tfschema:"name"`
+	ResourceGroupName               string                 `tfschema:"resource_group_name"`
+	Location                        string                 `tfschema:"location"`
+	EnablePublicNetworkAccess       bool                   `tfschema:"public_network_access_enabled"`
+	QueryEndpoint                   string                 `tfschema:"query_endpoint"`
+	DefaultDataCollectionEndpointId string                 `tfschema:"default_data_collection_endpoint_id"`
+	DefaultDataCollectionRuleId     string                 `tfschema:"default_data_collection_rule_id"`
+	Tags                            map[string]interface{} `tfschema:"tags"`
+}
+
+type WorkspaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkspaceResource{}
+
+func (r WorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MonitorWorkspaceName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"public_network_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WorkspaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_data_collection_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WorkspaceResource) ResourceType() string {
+	return "azurerm_monitor_workspace"
+}
+
+func (r WorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MonitorWorkspaceID
+}
+
+func (r WorkspaceResource) ModelObject() interface{} {
+	return &Workspace{}
+}
+
+func (r WorkspaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state Workspace
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewMonitorWorkspaceID(subscriptionId, state.ResourceGroupName, state.Name)
+			metadata.Logger.Infof("creating %s", id)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := azuremonitorworkspaces.AzureMonitorWorkspaceResource{
+				Location: location.Normalize(state.Location),
+				Properties: &azuremonitorworkspaces.AzureMonitorWorkspace{
+					PublicNetworkAccess: expandMonitorWorkspacePublicNetworkAccess(state.EnablePublicNetworkAccess),
+				},
+				Tags: tags.Expand(state.Tags),
+			}
+
+			if _, err := client.Create(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := Workspace{
+				Name:                      id.AccountName,
+				ResourceGroupName:         id.ResourceGroup,
+				EnablePublicNetworkAccess: true,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if props.PublicNetworkAccess != nil {
+						state.EnablePublicNetworkAccess = *props.PublicNetworkAccess == azuremonitorworkspaces.PublicNetworkAccessEnabled
+					}
+
+					if metrics := props.Metrics; metrics != nil {
+						state.QueryEndpoint = pointer.From(metrics.PrometheusQueryEndpoint)
+					}
+
+					if ingestion := props.DefaultIngestionSettings; ingestion != nil {
+						state.DefaultDataCollectionEndpointId = pointer.From(ingestion.DataCollectionEndpointResourceId)
+						state.DefaultDataCollectionRuleId = pointer.From(ingestion.DataCollectionRuleResourceId)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state Workspace
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			// the PATCH operation only supports updating the tags, so other changes require the resource to be PUT again
+			if metadata.ResourceData.HasChange("public_network_access_enabled") {
+				existing, err := client.Get(ctx, *id)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+				if existing.Model == nil {
+					return fmt.Errorf("retrieving %s: `model` was nil", *id)
+				}
+
+				// the read-only properties are rejected by the API when specified, so only the writable ones are sent
+				input := azuremonitorworkspaces.AzureMonitorWorkspaceResource{
+					Location: existing.Model.Location,
+					Properties: &azuremonitorworkspaces.AzureMonitorWorkspace{
+						PublicNetworkAccess: expandMonitorWorkspacePublicNetworkAccess(state.EnablePublicNetworkAccess),
+					},
+					Tags: existing.Model.Tags,
+				}
+				if metadata.ResourceData.HasChange("tags") {
+					input.Tags = tags.Expand(state.Tags)
+				}
+
+				if _, err := client.Create(ctx, *id, input); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+				return nil
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				input := azuremonitorworkspaces.AzureMonitorWorkspaceResourceForUpdate{
+					Tags: tags.Expand(state.Tags),
+				}
+				if _, err := client.Update(ctx, *id, input); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AzureMonitorWorkspacesClient
+			id, err := parse.MonitorWorkspaceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandMonitorWorkspacePublicNetworkAccess(input bool) *azuremonitorworkspaces.PublicNetworkAccess {
+	if input {
+		return pointer.To(azuremonitorworkspaces.PublicNetworkAccessEnabled)
+	}
+	return pointer.To(azuremonitorworkspaces.PublicNetworkAccessDisabled)
+}
@@ -27,10 +27,12 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AlertProcessingRuleActionGroupResource{},
 		AlertProcessingRuleSuppressionResource{},
+		AlertPrometheusRuleGroupResource{},
 		DataCollectionEndpointResource{},
 		DataCollectionRuleAssociationResource{},
 		DataCollectionRuleResource{},
 		ScheduledQueryRulesAlertV2Resource{},
+		WorkspaceResource{},
 	}
 }
 
@@ -67,6 +69,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_monitor_action_rule_suppression":     resourceMonitorActionRuleSuppression(),
 		"azurerm_monitor_activity_log_alert":          resourceMonitorActivityLogAlert(),
 		"azurerm_monitor_diagnostic_setting":          resourceMonitorDiagnosticSetting(),
+		"azurerm_monitor_bulk_diagnostic_setting":     resourceMonitorBulkDiagnosticSetting(),
 		"azurerm_monitor_log_profile":                 resourceMonitorLogProfile(),
 		"azurerm_monitor_metric_alert":                resourceMonitorMetricAlert(),
 		"azurerm_monitor_private_link_scope":          resourceMonitorPrivateLinkScope(),
@@ -0,0 +1,23 @@
+package datacollectionrules
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type DataCollectionRulesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDataCollectionRulesClientWithBaseURI(api environments.Api) (*DataCollectionRulesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "datacollectionrules", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DataCollectionRulesClient: %+v", err)
+	}
+
+	return &DataCollectionRulesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,189 @@
+package datacollectionrules
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type AzureMonitorMetricsDestination struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type ColumnDefinition struct {
+	Name *string                    `json:"name,omitempty"`
+	Type *KnownColumnDefinitionType `json:"type,omitempty"`
+}
+
+type DataCollectionRule struct {
+	DataCollectionEndpointId *string                                   `json:"dataCollectionEndpointId,omitempty"`
+	DataFlows                *[]DataFlow                               `json:"dataFlows,omitempty"`
+	DataSources              *DataSourcesSpec                          `json:"dataSources,omitempty"`
+	Description              *string                                   `json:"description,omitempty"`
+	Destinations             *DestinationsSpec                         `json:"destinations,omitempty"`
+	ImmutableId              *string                                   `json:"immutableId,omitempty"`
+	Metadata                 *Metadata                                 `json:"metadata,omitempty"`
+	ProvisioningState        *KnownDataCollectionRuleProvisioningState `json:"provisioningState,omitempty"`
+	StreamDeclarations       *map[string]StreamDeclaration             `json:"streamDeclarations,omitempty"`
+}
+
+type DataCollectionRuleResource struct {
+	Etag       *string                                  `json:"etag,omitempty"`
+	Id         *string                                  `json:"id,omitempty"`
+	Identity   *identity.LegacySystemAndUserAssignedMap `json:"identity,omitempty"`
+	Kind       *KnownDataCollectionRuleResourceKind     `json:"kind,omitempty"`
+	Location   string                                   `json:"location"`
+	Name       *string                                  `json:"name,omitempty"`
+	Properties *DataCollectionRule                      `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData                   `json:"systemData,omitempty"`
+	Tags       *map[string]string                       `json:"tags,omitempty"`
+	Type       *string                                  `json:"type,omitempty"`
+}
+
+type DataFlow struct {
+	Destinations *[]string               `json:"destinations,omitempty"`
+	OutputStream *string                 `json:"outputStream,omitempty"`
+	Streams      *[]KnownDataFlowStreams `json:"streams,omitempty"`
+	TransformKql *string                 `json:"transformKql,omitempty"`
+}
+
+type DataImportSources struct {
+	EventHub *EventHubDataSource `json:"eventHub,omitempty"`
+}
+
+type DataSourcesSpec struct {
+	DataImports         *DataImportSources               `json:"dataImports,omitempty"`
+	Extensions          *[]ExtensionDataSource           `json:"extensions,omitempty"`
+	IisLogs             *[]IisLogsDataSource             `json:"iisLogs,omitempty"`
+	LogFiles            *[]LogFilesDataSource            `json:"logFiles,omitempty"`
+	PerformanceCounters *[]PerfCounterDataSource         `json:"performanceCounters,omitempty"`
+	PlatformTelemetry   *[]PlatformTelemetryDataSource   `json:"platformTelemetry,omitempty"`
+	PrometheusForwarder *[]PrometheusForwarderDataSource `json:"prometheusForwarder,omitempty"`
+	Syslog              *[]SyslogDataSource              `json:"syslog,omitempty"`
+	WindowsEventLogs    *[]WindowsEventLogDataSource     `json:"windowsEventLogs,omitempty"`
+	WindowsFirewallLogs *[]WindowsFirewallLogsDataSource `json:"windowsFirewallLogs,omitempty"`
+}
+
+type DestinationsSpec struct {
+	AzureMonitorMetrics *AzureMonitorMetricsDestination `json:"azureMonitorMetrics,omitempty"`
+	EventHubs           *[]EventHubDestination          `json:"eventHubs,omitempty"`
+	EventHubsDirect     *[]EventHubDirectDestination    `json:"eventHubsDirect,omitempty"`
+	LogAnalytics        *[]LogAnalyticsDestination      `json:"logAnalytics,omitempty"`
+	MonitoringAccounts  *[]MonitoringAccountDestination `json:"monitoringAccounts,omitempty"`
+	StorageAccounts     *[]StorageBlobDestination       `json:"storageAccounts,omitempty"`
+	StorageBlobsDirect  *[]StorageBlobDestination       `json:"storageBlobsDirect,omitempty"`
+	StorageTablesDirect *[]StorageTableDestination      `json:"storageTablesDirect,omitempty"`
+}
+
+type EventHubDataSource struct {
+	ConsumerGroup *string `json:"consumerGroup,omitempty"`
+	Name          *string `json:"name,omitempty"`
+	Stream        *string `json:"stream,omitempty"`
+}
+
+type EventHubDestination struct {
+	EventHubResourceId *string `json:"eventHubResourceId,omitempty"`
+	Name               *string `json:"name,omitempty"`
+}
+
+type EventHubDirectDestination struct {
+	EventHubResourceId *string `json:"eventHubResourceId,omitempty"`
+	Name               *string `json:"name,omitempty"`
+}
+
+type ExtensionDataSource struct {
+	ExtensionName     string                             `json:"extensionName"`
+	ExtensionSettings *interface{}                       `json:"extensionSettings,omitempty"`
+	InputDataSources  *[]string                          `json:"inputDataSources,omitempty"`
+	Name              *string                            `json:"name,omitempty"`
+	Streams           *[]KnownExtensionDataSourceStreams `json:"streams,omitempty"`
+}
+
+type IisLogsDataSource struct {
+	LogDirectories *[]string `json:"logDirectories,omitempty"`
+	Name           *string   `json:"name,omitempty"`
+	Streams        []string  `json:"streams"`
+}
+
+type LogAnalyticsDestination struct {
+	Name                *string `json:"name,omitempty"`
+	WorkspaceId         *string `json:"workspaceId,omitempty"`
+	WorkspaceResourceId *string `json:"workspaceResourceId,omitempty"`
+}
+
+type LogFileSettings struct {
+	Text *LogFileTextSettings `json:"text,omitempty"`
+}
+
+type LogFileTextSettings struct {
+	RecordStartTimestampFormat KnownLogFileTextSettingsRecordStartTimestampFormat `json:"recordStartTimestampFormat"`
+}
+
+type LogFilesDataSource struct {
+	FilePatterns []string                      `json:"filePatterns"`
+	Format       KnownLogFilesDataSourceFormat `json:"format"`
+	Name         *string                       `json:"name,omitempty"`
+	Settings     *LogFileSettings              `json:"settings,omitempty"`
+	Streams      []string                      `json:"streams"`
+}
+
+type Metadata struct {
+	ProvisionedBy *string `json:"provisionedBy,omitempty"`
+}
+
+type MonitoringAccountDestination struct {
+	AccountId         *string `json:"accountId,omitempty"`
+	AccountResourceId *string `json:"accountResourceId,omitempty"`
+	Name              *string `json:"name,omitempty"`
+}
+
+type PerfCounterDataSource struct {
+	CounterSpecifiers          *[]string                            `json:"counterSpecifiers,omitempty"`
+	Name                       *string                              `json:"name,omitempty"`
+	SamplingFrequencyInSeconds *int64                               `json:"samplingFrequencyInSeconds,omitempty"`
+	Streams                    *[]KnownPerfCounterDataSourceStreams `json:"streams,omitempty"`
+}
+
+type PlatformTelemetryDataSource struct {
+	Name    *string  `json:"name,omitempty"`
+	Streams []string `json:"streams"`
+}
+
+type PrometheusForwarderDataSource struct {
+	LabelIncludeFilter *map[string]string                           `json:"labelIncludeFilter,omitempty"`
+	Name               *string                                      `json:"name,omitempty"`
+	Streams            *[]KnownPrometheusForwarderDataSourceStreams `json:"streams,omitempty"`
+}
+
+type StorageBlobDestination struct {
+	ContainerName            *string `json:"containerName,omitempty"`
+	Name                     *string `json:"name,omitempty"`
+	StorageAccountResourceId *string `json:"storageAccountResourceId,omitempty"`
+}
+
+type StorageTableDestination struct {
+	Name                     *string `json:"name,omitempty"`
+	StorageAccountResourceId *string `json:"storageAccountResourceId,omitempty"`
+	TableName                *string `json:"tableName,omitempty"`
+}
+
+type StreamDeclaration struct {
+	Columns *[]ColumnDefinition `json:"columns,omitempty"`
+}
+
+type SyslogDataSource struct {
+	FacilityNames *[]KnownSyslogDataSourceFacilityNames `json:"facilityNames,omitempty"`
+	LogLevels     *[]KnownSyslogDataSourceLogLevels     `json:"logLevels,omitempty"`
+	Name          *string                               `json:"name,omitempty"`
+	Streams       *[]KnownSyslogDataSourceStreams       `json:"streams,omitempty"`
+}
+
+type WindowsEventLogDataSource struct {
+	Name         *string                                  `json:"name,omitempty"`
+	Streams      *[]KnownWindowsEventLogDataSourceStreams `json:"streams,omitempty"`
+	XPathQueries *[]string                                `json:"xPathQueries,omitempty"`
+}
+
+type WindowsFirewallLogsDataSource struct {
+	Name    *string  `json:"name,omitempty"`
+	Streams []string `json:"streams"`
+}
@@ -0,0 +1,3 @@
+package datacollectionrules
+
+const defaultApiVersion = "2022-06-01"
@@ -0,0 +1,23 @@
+package prometheusrulegroups
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type PrometheusRuleGroupsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewPrometheusRuleGroupsClientWithBaseURI(api environments.Api) (*PrometheusRuleGroupsClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "prometheusrulegroups", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating PrometheusRuleGroupsClient: %+v", err)
+	}
+
+	return &PrometheusRuleGroupsClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package prometheusrulegroups
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type PrometheusRule struct {
+	Actions              *[]PrometheusRuleGroupAction        `json:"actions,omitempty"`
+	Alert                *string                             `json:"alert,omitempty"`
+	Annotations          *map[string]string                  `json:"annotations,omitempty"`
+	Enabled              *bool                               `json:"enabled,omitempty"`
+	Expression           string                              `json:"expression"`
+	For                  *string                             `json:"for,omitempty"`
+	Labels               *map[string]string                  `json:"labels,omitempty"`
+	Record               *string                             `json:"record,omitempty"`
+	ResolveConfiguration *PrometheusRuleResolveConfiguration `json:"resolveConfiguration,omitempty"`
+	Severity             *int64                              `json:"severity,omitempty"`
+}
+
+type PrometheusRuleGroupAction struct {
+	ActionGroupId    *string            `json:"actionGroupId,omitempty"`
+	ActionProperties *map[string]string `json:"actionProperties,omitempty"`
+}
+
+type PrometheusRuleGroupProperties struct {
+	ClusterName *string          `json:"clusterName,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Enabled     *bool            `json:"enabled,omitempty"`
+	Interval    *string          `json:"interval,omitempty"`
+	Rules       []PrometheusRule `json:"rules"`
+	Scopes      []string         `json:"scopes"`
+}
+
+type PrometheusRuleGroupResource struct {
+	Id         *string                       `json:"id,omitempty"`
+	Location   string                        `json:"location"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties PrometheusRuleGroupProperties `json:"properties"`
+	SystemData *systemdata.SystemData        `json:"systemData,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type PrometheusRuleResolveConfiguration struct {
+	AutoResolved  *bool   `json:"autoResolved,omitempty"`
+	TimeToResolve *string `json:"timeToResolve,omitempty"`
+}
@@ -0,0 +1,3 @@
+package prometheusrulegroups
+
+const defaultApiVersion = "2023-03-01"
@@ -0,0 +1,23 @@
+package azuremonitorworkspaces
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type AzureMonitorWorkspacesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewAzureMonitorWorkspacesClientWithBaseURI(api environments.Api) (*AzureMonitorWorkspacesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "azuremonitorworkspaces", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AzureMonitorWorkspacesClient: %+v", err)
+	}
+
+	return &AzureMonitorWorkspacesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,38 @@
+package azuremonitorworkspaces
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type AzureMonitorWorkspace struct {
+	AccountId                *string              `json:"accountId,omitempty"`
+	DefaultIngestionSettings *IngestionSettings   `json:"defaultIngestionSettings,omitempty"`
+	Metrics                  *Metrics             `json:"metrics,omitempty"`
+	ProvisioningState        *ProvisioningState   `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess `json:"publicNetworkAccess,omitempty"`
+}
+
+type AzureMonitorWorkspaceResource struct {
+	Etag       *string                `json:"etag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Location   string                 `json:"location"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *AzureMonitorWorkspace `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Tags       *map[string]string     `json:"tags,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type AzureMonitorWorkspaceResourceForUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
+
+type IngestionSettings struct {
+	DataCollectionEndpointResourceId *string `json:"dataCollectionEndpointResourceId,omitempty"`
+	DataCollectionRuleResourceId     *string `json:"dataCollectionRuleResourceId,omitempty"`
+}
+
+type Metrics struct {
+	InternalId              *string `json:"internalId,omitempty"`
+	PrometheusQueryEndpoint *string `json:"prometheusQueryEndpoint,omitempty"`
+}
@@ -0,0 +1,3 @@
+package azuremonitorworkspaces
+
+const defaultApiVersion = "2023-04-03"
@@ -0,0 +1,26 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func MonitorWorkspaceName(i interface{}, k string) (warning []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if len(v) < 4 || len(v) > 44 {
+		errors = append(errors, fmt.Errorf("%q must be between 4 and 44 characters in length", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]*[a-zA-Z0-9]$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q only allows alphanumeric characters and hyphens, and must start and end with an alphanumeric character", k))
+		return
+	}
+
+	return
+}
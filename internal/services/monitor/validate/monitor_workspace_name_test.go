@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMonitorWorkspaceName(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected bool
+	}{
+		{
+			input:    "",
+			expected: false,
+		},
+		{
+			input:    "abc",
+			expected: false,
+		},
+		{
+			input:    "abcd",
+			expected: true,
+		},
+		{
+			input:    "acc-test-01",
+			expected: true,
+		},
+		{
+			input:    "-abcd",
+			expected: false,
+		},
+		{
+			input:    "abcd-",
+			expected: false,
+		},
+		{
+			input:    "ab_cd",
+			expected: false,
+		},
+		{
+			input:    strings.Repeat("s", 44),
+			expected: true,
+		},
+		{
+			input:    strings.Repeat("s", 45),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		_, errors := MonitorWorkspaceName(v.input, "name")
+		actual := len(errors) == 0
+		if v.expected != actual {
+			t.Fatalf("Expected %t but got %t", v.expected, actual)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package client
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -20,6 +22,10 @@ type Client struct {
 	InstanceFailoverGroupsClient                       *sql.InstanceFailoverGroupsClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	JobsClient                                         *sql.JobsClient
+	JobStepsClient                                     *sql.JobStepsClient
+	JobTargetGroupsClient                              *sql.JobTargetGroupsClient
+	LongTermRetentionBackupsClient                     *sql.LongTermRetentionBackupsClient
 	LongTermRetentionPoliciesClient                    *sql.LongTermRetentionPoliciesClient
 	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
@@ -43,6 +49,8 @@ type Client struct {
 	ServerVulnerabilityAssessmentsClient               *sql.ServerVulnerabilityAssessmentsClient
 	ServersClient                                      *sql.ServersClient
 	TransparentDataEncryptionsClient                   *sql.TransparentDataEncryptionsClient
+	VirtualMachineGroupsClient                         *sqlvirtualmachinegroups.SqlVirtualMachineGroupsClient
+	VirtualMachinesAvailabilityGroupListenersClient    *availabilitygrouplisteners.AvailabilityGroupListenersClient
 	VirtualMachinesClient                              *sqlvirtualmachines.SqlVirtualMachinesClient
 	VirtualNetworkRulesClient                          *sql.VirtualNetworkRulesClient
 }
@@ -87,6 +95,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	jobsClient := sql.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobStepsClient := sql.NewJobStepsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStepsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobTargetGroupsClient := sql.NewJobTargetGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobTargetGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	longTermRetentionBackupsClient := sql.NewLongTermRetentionBackupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&longTermRetentionBackupsClient.Client, o.ResourceManagerAuthorizer)
+
 	longTermRetentionPoliciesClient := sql.NewLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&longTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -156,6 +176,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	transparentDataEncryptionsClient := sql.NewTransparentDataEncryptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&transparentDataEncryptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	virtualMachineGroupsClient := sqlvirtualmachinegroups.NewSqlVirtualMachineGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachineGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	virtualMachinesAvailabilityGroupListenersClient := availabilitygrouplisteners.NewAvailabilityGroupListenersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&virtualMachinesAvailabilityGroupListenersClient.Client, o.ResourceManagerAuthorizer)
+
 	virtualMachinesClient := sqlvirtualmachines.NewSqlVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&virtualMachinesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -176,6 +202,10 @@ func NewClient(o *common.ClientOptions) *Client {
 		InstanceFailoverGroupsClient:                     &instanceFailoverGroupsClient,
 		JobAgentsClient:                                  &jobAgentsClient,
 		JobCredentialsClient:                             &jobCredentialsClient,
+		JobsClient:                                       &jobsClient,
+		JobStepsClient:                                   &jobStepsClient,
+		JobTargetGroupsClient:                            &jobTargetGroupsClient,
+		LongTermRetentionBackupsClient:                   &longTermRetentionBackupsClient,
 		LongTermRetentionPoliciesClient:                  &longTermRetentionPoliciesClient,
 		ManagedDatabasesClient:                           &managedDatabasesClient,
 		ManagedInstanceAdministratorsClient:              &managedInstancesAdministratorsClient,
@@ -199,6 +229,8 @@ func NewClient(o *common.ClientOptions) *Client {
 		ServerVulnerabilityAssessmentsClient:             &serverVulnerabilityAssessmentsClient,
 		ServersClient:                                    &serversClient,
 		TransparentDataEncryptionsClient:                 &transparentDataEncryptionsClient,
+		VirtualMachineGroupsClient:                       &virtualMachineGroupsClient,
+		VirtualMachinesAvailabilityGroupListenersClient:  &virtualMachinesAvailabilityGroupListenersClient,
 		VirtualMachinesClient:                            &virtualMachinesClient,
 		VirtualNetworkRulesClient:                        &virtualNetworkRulesClient,
 	}
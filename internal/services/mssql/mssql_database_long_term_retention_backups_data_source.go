@@ -0,0 +1,194 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceMsSqlDatabaseLongTermRetentionBackups() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMsSqlDatabaseLongTermRetentionBackupsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				StateFunc:    location.StateFunc,
+				ValidateFunc: location.EnhancedValidate,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+
+			"server_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateMsSqlDatabaseName,
+			},
+
+			"only_latest_per_database": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"backups": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"database_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backup_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backup_expiration_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"backup_storage_redundancy": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"database_deletion_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMsSqlDatabaseLongTermRetentionBackupsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.LongTermRetentionBackupsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	locationName := location.Normalize(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	onlyLatestPerDatabase := utils.Bool(d.Get("only_latest_per_database").(bool))
+
+	// the backups outlive both the server and the database, so these are identified by location rather than the server
+	id := fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/locations/%s/longTermRetentionServers/%s", subscriptionId, resourceGroup, locationName, serverName)
+
+	backups := make([]sql.LongTermRetentionBackup, 0)
+	if databaseName != "" {
+		id = fmt.Sprintf("%s/longTermRetentionDatabases/%s", id, databaseName)
+
+		iterator, err := client.ListByResourceGroupDatabaseComplete(ctx, resourceGroup, locationName, serverName, databaseName, onlyLatestPerDatabase, sql.DatabaseStateAll)
+		if err != nil {
+			return fmt.Errorf("listing Long Term Retention Backups for Database %q (Server %q / Location %q / Resource Group %q): %+v", databaseName, serverName, locationName, resourceGroup, err)
+		}
+		for iterator.NotDone() {
+			backups = append(backups, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing next page of Long Term Retention Backups for Database %q (Server %q / Location %q / Resource Group %q): %+v", databaseName, serverName, locationName, resourceGroup, err)
+			}
+		}
+	} else {
+		iterator, err := client.ListByResourceGroupServerComplete(ctx, resourceGroup, locationName, serverName, onlyLatestPerDatabase, sql.DatabaseStateAll)
+		if err != nil {
+			return fmt.Errorf("listing Long Term Retention Backups for Server %q (Location %q / Resource Group %q): %+v", serverName, locationName, resourceGroup, err)
+		}
+		for iterator.NotDone() {
+			backups = append(backups, iterator.Value())
+			if err := iterator.NextWithContext(ctx); err != nil {
+				return fmt.Errorf("listing next page of Long Term Retention Backups for Server %q (Location %q / Resource Group %q): %+v", serverName, locationName, resourceGroup, err)
+			}
+		}
+	}
+
+	d.SetId(id)
+	d.Set("location", locationName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("server_name", serverName)
+	d.Set("database_name", databaseName)
+
+	if err := d.Set("backups", flattenMsSqlDatabaseLongTermRetentionBackups(backups)); err != nil {
+		return fmt.Errorf("setting `backups`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenMsSqlDatabaseLongTermRetentionBackups(input []sql.LongTermRetentionBackup) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		var id, name, databaseName, backupTime, backupExpirationTime, databaseDeletionTime, backupStorageRedundancy string
+		if item.ID != nil {
+			id = *item.ID
+		}
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		if props := item.LongTermRetentionBackupProperties; props != nil {
+			if props.DatabaseName != nil {
+				databaseName = *props.DatabaseName
+			}
+			if props.BackupTime != nil {
+				backupTime = props.BackupTime.Format(time.RFC3339)
+			}
+			if props.BackupExpirationTime != nil {
+				backupExpirationTime = props.BackupExpirationTime.Format(time.RFC3339)
+			}
+			if props.DatabaseDeletionTime != nil {
+				databaseDeletionTime = props.DatabaseDeletionTime.Format(time.RFC3339)
+			}
+			backupStorageRedundancy = string(props.BackupStorageRedundancy)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                        id,
+			"name":                      name,
+			"database_name":             databaseName,
+			"backup_time":               backupTime,
+			"backup_expiration_time":    backupExpirationTime,
+			"backup_storage_redundancy": backupStorageRedundancy,
+			"database_deletion_time":    databaseDeletionTime,
+		})
+	}
+
+	return results
+}
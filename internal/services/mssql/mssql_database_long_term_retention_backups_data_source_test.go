@@ -0,0 +1,38 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlDatabaseLongTermRetentionBackupsDataSource struct{}
+
+func TestAccDataSourceMsSqlDatabaseLongTermRetentionBackups_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_database_long_term_retention_backups", "test")
+	r := MsSqlDatabaseLongTermRetentionBackupsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("backups.#").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlDatabaseLongTermRetentionBackupsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_database_long_term_retention_backups" "test" {
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mssql_server.test.name
+  database_name       = azurerm_mssql_database.test.name
+}
+`, MsSqlDatabaseResource{}.withLongTermRetentionPolicy(data))
+}
@@ -16,13 +16,13 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
-	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -67,7 +67,10 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 				}
 
 				return nil
-			}),
+			},
+
+			restore.ValidatePointInTimeInDiff("creation_source_database_id", "restore_point_in_time", msSqlDatabaseRestoreWindow),
+		),
 	}
 }
 
@@ -251,6 +254,9 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	if _, dbok := d.GetOk("restore_dropped_database_id"); ok && createMode.(string) == string(sql.CreateModeRestore) && !dbok {
 		return fmt.Errorf("'restore_dropped_database_id' is required for create_mode %s", createMode.(string))
 	}
+	if _, dbok := d.GetOk("long_term_retention_backup_id"); ok && createMode.(string) == string(sql.CreateModeRestoreLongTermRetentionBackup) && !dbok {
+		return fmt.Errorf("'long_term_retention_backup_id' is required for create_mode %s", createMode.(string))
+	}
 
 	// we should not specify the value of `maintenance_configuration_name` when `elastic_pool_id` is set since its value depends on the elastic pool's `maintenance_configuration_name` value.
 	if _, ok := d.GetOk("elastic_pool_id"); !ok {
@@ -311,6 +317,10 @@ func resourceMsSqlDatabaseCreateUpdate(d *pluginsdk.ResourceData, meta interface
 		params.DatabaseProperties.RestorableDroppedDatabaseID = utils.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("long_term_retention_backup_id"); ok {
+		params.DatabaseProperties.LongTermRetentionBackupResourceID = utils.String(v.(string))
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.Name, params)
 	if err != nil {
 		return fmt.Errorf("creating/updating %s: %+v", id, err)
@@ -921,13 +931,13 @@ func resourceMsSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: azValidate.FloatInSlice([]float64{0, 0.5, 0.75, 1, 1.25, 1.5, 1.75, 2, 2.25, 2.5, 3, 4, 5, 6, 8, 10, 12, 14, 16, 18, 20, 24, 32, 40}),
 		},
 
-		"restore_point_in_time": {
-			Type:             pluginsdk.TypeString,
-			Optional:         true,
-			Computed:         true,
-			DiffSuppressFunc: suppress.RFC3339Time,
-			ValidateFunc:     validation.IsRFC3339Time,
-		},
+		"restore_point_in_time": func() *pluginsdk.Schema {
+			// this is returned by the API and, since `create_mode` is only used during creation, doesn't force a new resource
+			s := restore.PointInTimeSchema()
+			s.Computed = true
+			s.ForceNew = false
+			return s
+		}(),
 
 		"recover_database_id": {
 			Type:         pluginsdk.TypeString,
@@ -941,6 +951,13 @@ func resourceMsSqlDatabaseSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: validate.RestorableDatabaseID,
 		},
 
+		"long_term_retention_backup_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.LongTermRetentionBackupID,
+		},
+
 		"read_replica_count": {
 			Type:         pluginsdk.TypeInt,
 			Optional:     true,
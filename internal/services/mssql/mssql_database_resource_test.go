@@ -621,6 +621,32 @@ func TestAccMsSqlDatabase_withLongTermRetentionPolicy(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlDatabase_restoreLongTermRetentionBackup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
+	r := MsSqlDatabaseResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withLongTermRetentionPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the first Long Term Retention Backup is taken some time after the policy has been configured
+			PreConfig: func() { time.Sleep(60 * time.Minute) },
+			Config:    r.restoreLongTermRetentionBackup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_mssql_database.restore").ExistsInAzure(r),
+				check.That("azurerm_mssql_database.restore").Key("create_mode").HasValue("RestoreLongTermRetentionBackup"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccMsSqlDatabase_withShortTermRetentionPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
 	r := MsSqlDatabaseResource{}
@@ -1449,6 +1475,27 @@ resource "azurerm_mssql_database" "test" {
 `, r.template(data), data.RandomIntOfLength(15), data.RandomInteger)
 }
 
+func (r MsSqlDatabaseResource) restoreLongTermRetentionBackup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "azurerm_mssql_database_long_term_retention_backups" "test" {
+  location                 = azurerm_resource_group.test.location
+  resource_group_name      = azurerm_resource_group.test.name
+  server_name              = azurerm_mssql_server.test.name
+  database_name            = azurerm_mssql_database.test.name
+  only_latest_per_database = true
+}
+
+resource "azurerm_mssql_database" "restore" {
+  name                          = "acctest-dbr-%[2]d"
+  server_id                     = azurerm_mssql_server.test.id
+  create_mode                   = "RestoreLongTermRetentionBackup"
+  long_term_retention_backup_id = data.azurerm_mssql_database_long_term_retention_backups.test.backups.0.id
+}
+`, r.withLongTermRetentionPolicy(data), data.RandomInteger)
+}
+
 func (r MsSqlDatabaseResource) withLongTermRetentionPolicyUpdated(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %[1]s
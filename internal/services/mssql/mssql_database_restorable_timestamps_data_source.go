@@ -0,0 +1,85 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceMsSqlDatabaseRestorableTimestamps() *pluginsdk.Resource {
+	s := map[string]*pluginsdk.Schema{
+		"database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.DatabaseID,
+		},
+	}
+	for k, v := range restore.SchemaDataSource() {
+		s[k] = v
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceMsSqlDatabaseRestorableTimestampsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceMsSqlDatabaseRestorableTimestampsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	window, err := msSqlDatabaseRestoreWindow(ctx, meta, id.ID())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("database_id", id.ID())
+
+	return window.Flatten(d)
+}
+
+// msSqlDatabaseRestoreWindow returns the range of time which the MsSql Database can be restored to
+func msSqlDatabaseRestoreWindow(ctx context.Context, meta interface{}, sourceId string) (*restore.Window, error) {
+	client := meta.(*clients.Client).MSSQL.DatabasesClient
+
+	id, err := parse.DatabaseID(sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("%s was not found", *id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.DatabaseProperties == nil || resp.DatabaseProperties.EarliestRestoreDate == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.earliestRestoreDate` was nil", *id)
+	}
+
+	return &restore.Window{
+		Earliest: resp.DatabaseProperties.EarliestRestoreDate.Time,
+		Latest:   time.Now().UTC(),
+	}, nil
+}
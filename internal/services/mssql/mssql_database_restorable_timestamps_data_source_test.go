@@ -0,0 +1,36 @@
+package mssql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MsSqlDatabaseRestorableTimestampsDataSource struct{}
+
+func TestAccDataSourceMsSqlDatabaseRestorableTimestamps_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mssql_database_restorable_timestamps", "test")
+	r := MsSqlDatabaseRestorableTimestampsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("earliest_restore_time").Exists(),
+				check.That(data.ResourceName).Key("latest_restore_time").Exists(),
+			),
+		},
+	})
+}
+
+func (MsSqlDatabaseRestorableTimestampsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mssql_database_restorable_timestamps" "test" {
+  database_id = azurerm_mssql_database.test.id
+}
+`, MsSqlDatabaseResource{}.basic(data))
+}
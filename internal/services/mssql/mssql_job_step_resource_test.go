@@ -0,0 +1,178 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlJobStepResource struct{}
+
+func TestAccMsSqlJobStep_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlJobStep_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlJobStep_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_step", "test")
+	r := MsSqlJobStepResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlJobStepResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.JobStepID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.JobStepsClient.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.JobName, id.StepName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlJobStepResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_job_target_group" "test" {
+  name         = "acctest-tg-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+
+  job_target {
+    server_name   = azurerm_mssql_server.test.name
+    type          = "SqlDatabase"
+    database_name = azurerm_mssql_database.test.name
+  }
+}
+
+resource "azurerm_mssql_job" "test" {
+  name         = "acctest-job-%[2]d"
+  job_agent_id = azurerm_mssql_job_agent.test.id
+}
+`, MsSqlJobTargetGroupResource{}.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobStepResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctest-step-%d"
+  job_id              = azurerm_mssql_job.test.id
+  job_credential_id   = azurerm_mssql_job_credential.test.id
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+  job_step_index      = 1
+  sql_script          = "SELECT 1"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlJobStepResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "import" {
+  name                = azurerm_mssql_job_step.test.name
+  job_id              = azurerm_mssql_job_step.test.job_id
+  job_credential_id   = azurerm_mssql_job_step.test.job_credential_id
+  job_target_group_id = azurerm_mssql_job_step.test.job_target_group_id
+  job_step_index      = azurerm_mssql_job_step.test.job_step_index
+  sql_script          = azurerm_mssql_job_step.test.sql_script
+}
+`, r.basic(data))
+}
+
+func (r MsSqlJobStepResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_job_step" "test" {
+  name                = "acctest-step-%d"
+  job_id              = azurerm_mssql_job.test.id
+  job_credential_id   = azurerm_mssql_job_credential.test.id
+  job_target_group_id = azurerm_mssql_job_target_group.test.id
+  job_step_index      = 1
+  sql_script          = <<EOT
+IF NOT EXISTS (SELECT * FROM sys.objects WHERE [name] = N'Pets')
+  CREATE TABLE Pets (
+    Animal NVARCHAR(50),
+    Name NVARCHAR(50),
+  );
+EOT
+
+  initial_retry_interval_seconds    = 10
+  maximum_retry_interval_seconds    = 300
+  retry_attempts                    = 5
+  retry_interval_backoff_multiplier = 3
+  timeout_seconds                   = 3600
+
+  output_target {
+    mssql_database_id = azurerm_mssql_database.test.id
+    table_name        = "JobOutput"
+    schema_name       = "dbo"
+    job_credential_id = azurerm_mssql_job_credential.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
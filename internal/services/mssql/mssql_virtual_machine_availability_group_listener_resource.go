@@ -0,0 +1,567 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	loadBalancerParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/parse"
+	loadBalancerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/loadbalancer/validate"
+	networkParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlVirtualMachineAvailabilityGroupListenerModel struct {
+	Name                       string                                                      `tfschema:"name"`
+	SqlVirtualMachineGroupId   string                                                      `tfschema:"sql_virtual_machine_group_id"`
+	AvailabilityGroupName      string                                                      `tfschema:"availability_group_name"`
+	Port                       int                                                         `tfschema:"port"`
+	LoadBalancerConfiguration  []MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer  `tfschema:"load_balancer_configuration"`
+	MultiSubnetIpConfiguration []MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp `tfschema:"multi_subnet_ip_configuration"`
+	Replica                    []MsSqlVirtualMachineAvailabilityGroupListenerReplica       `tfschema:"replica"`
+}
+
+type MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer struct {
+	LoadBalancerId       string                                                  `tfschema:"load_balancer_id"`
+	PrivateIpAddress     []MsSqlVirtualMachineAvailabilityGroupListenerPrivateIp `tfschema:"private_ip_address"`
+	ProbePort            int                                                     `tfschema:"probe_port"`
+	SqlVirtualMachineIds []string                                                `tfschema:"sql_virtual_machine_ids"`
+}
+
+type MsSqlVirtualMachineAvailabilityGroupListenerPrivateIp struct {
+	IpAddress string `tfschema:"ip_address"`
+	SubnetId  string `tfschema:"subnet_id"`
+}
+
+type MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp struct {
+	PrivateIpAddress    string `tfschema:"private_ip_address"`
+	SqlVirtualMachineId string `tfschema:"sql_virtual_machine_id"`
+	SubnetId            string `tfschema:"subnet_id"`
+}
+
+type MsSqlVirtualMachineAvailabilityGroupListenerReplica struct {
+	SqlVirtualMachineId string `tfschema:"sql_virtual_machine_id"`
+	Role                string `tfschema:"role"`
+	Commit              string `tfschema:"commit"`
+	FailoverMode        string `tfschema:"failover_mode"`
+	ReadableSecondary   string `tfschema:"readable_secondary"`
+}
+
+type MsSqlVirtualMachineAvailabilityGroupListenerResource struct{}
+
+var _ sdk.Resource = MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) ResourceType() string {
+	return "azurerm_mssql_virtual_machine_availability_group_listener"
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) ModelObject() interface{} {
+	return &MsSqlVirtualMachineAvailabilityGroupListenerModel{}
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return availabilitygrouplisteners.ValidateAvailabilityGroupListenerID
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			// the name is used as the network name of the listener, which is a NetBIOS name
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{0,13}[a-zA-Z0-9]$|^[a-zA-Z0-9]$`),
+				"`name` must be between 1 and 15 characters long, contain only letters, numbers and hyphens and must not start or end with a hyphen",
+			),
+		},
+
+		"sql_virtual_machine_group_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sqlvirtualmachinegroups.ValidateSqlVirtualMachineGroupID,
+		},
+
+		"availability_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"load_balancer_configuration": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"load_balancer_configuration", "multi_subnet_ip_configuration"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"load_balancer_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: loadBalancerValidate.LoadBalancerID,
+					},
+
+					"private_ip_address": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"ip_address": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IsIPAddress,
+								},
+
+								"subnet_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: networkValidate.SubnetID,
+								},
+							},
+						},
+					},
+
+					"probe_port": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsPortNumber,
+					},
+
+					"sql_virtual_machine_ids": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: sqlvirtualmachines.ValidateSqlVirtualMachineID,
+						},
+					},
+				},
+			},
+		},
+
+		"multi_subnet_ip_configuration": {
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"load_balancer_configuration", "multi_subnet_ip_configuration"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"private_ip_address": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsIPAddress,
+					},
+
+					"sql_virtual_machine_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: sqlvirtualmachines.ValidateSqlVirtualMachineID,
+					},
+
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: networkValidate.SubnetID,
+					},
+				},
+			},
+		},
+
+		"replica": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"sql_virtual_machine_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: sqlvirtualmachines.ValidateSqlVirtualMachineID,
+					},
+
+					"role": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(availabilitygrouplisteners.PossibleValuesForRole(), false),
+					},
+
+					"commit": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(availabilitygrouplisteners.PossibleValuesForCommit(), false),
+					},
+
+					"failover_mode": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(availabilitygrouplisteners.PossibleValuesForFailover(), false),
+					},
+
+					"readable_secondary": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(availabilitygrouplisteners.PossibleValuesForReadableSecondary(), false),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlVirtualMachineAvailabilityGroupListenerModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.MSSQL.VirtualMachinesAvailabilityGroupListenersClient
+
+			groupId, err := sqlvirtualmachinegroups.ParseSqlVirtualMachineGroupID(model.SqlVirtualMachineGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := availabilitygrouplisteners.NewAvailabilityGroupListenerID(groupId.SubscriptionId, groupId.ResourceGroupName, groupId.SqlVirtualMachineGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id, availabilitygrouplisteners.DefaultGetOperationOptions())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := availabilitygrouplisteners.AvailabilityGroupListener{
+				Properties: &availabilitygrouplisteners.AvailabilityGroupListenerProperties{
+					AvailabilityGroupConfiguration: &availabilitygrouplisteners.AgConfiguration{
+						Replicas: expandMsSqlVirtualMachineAvailabilityGroupListenerReplicas(model.Replica),
+					},
+					CreateDefaultAvailabilityGroupIfNotExist: pointer.To(true),
+					LoadBalancerConfigurations:               expandMsSqlVirtualMachineAvailabilityGroupListenerLoadBalancerConfigurations(model.LoadBalancerConfiguration),
+					MultiSubnetIPConfigurations:              expandMsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIpConfigurations(model.MultiSubnetIpConfiguration),
+				},
+			}
+
+			if model.AvailabilityGroupName != "" {
+				parameters.Properties.AvailabilityGroupName = pointer.To(model.AvailabilityGroupName)
+			}
+
+			if model.Port != 0 {
+				parameters.Properties.Port = pointer.To(int64(model.Port))
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualMachinesAvailabilityGroupListenersClient
+
+			id, err := availabilitygrouplisteners.ParseAvailabilityGroupListenerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// the replicas are only returned when the availability group configuration is expanded
+			resp, err := client.Get(ctx, *id, availabilitygrouplisteners.GetOperationOptions{Expand: pointer.To("*")})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlVirtualMachineAvailabilityGroupListenerModel{
+				Name:                     id.AvailabilityGroupListenerName,
+				SqlVirtualMachineGroupId: sqlvirtualmachinegroups.NewSqlVirtualMachineGroupID(id.SubscriptionId, id.ResourceGroupName, id.SqlVirtualMachineGroupName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AvailabilityGroupName = pointer.From(props.AvailabilityGroupName)
+					state.Port = int(pointer.From(props.Port))
+
+					loadBalancerConfiguration, err := flattenMsSqlVirtualMachineAvailabilityGroupListenerLoadBalancerConfigurations(props.LoadBalancerConfigurations)
+					if err != nil {
+						return fmt.Errorf("flattening `load_balancer_configuration`: %+v", err)
+					}
+					state.LoadBalancerConfiguration = loadBalancerConfiguration
+
+					multiSubnetIpConfiguration, err := flattenMsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIpConfigurations(props.MultiSubnetIPConfigurations)
+					if err != nil {
+						return fmt.Errorf("flattening `multi_subnet_ip_configuration`: %+v", err)
+					}
+					state.MultiSubnetIpConfiguration = multiSubnetIpConfiguration
+
+					replicas, err := flattenMsSqlVirtualMachineAvailabilityGroupListenerReplicas(props.AvailabilityGroupConfiguration)
+					if err != nil {
+						return fmt.Errorf("flattening `replica`: %+v", err)
+					}
+					state.Replica = replicas
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualMachinesAvailabilityGroupListenersClient
+
+			id, err := availabilitygrouplisteners.ParseAvailabilityGroupListenerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlVirtualMachineAvailabilityGroupListenerLoadBalancerConfigurations(input []MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer) *[]availabilitygrouplisteners.LoadBalancerConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]availabilitygrouplisteners.LoadBalancerConfiguration, 0)
+	for _, v := range input {
+		configuration := availabilitygrouplisteners.LoadBalancerConfiguration{
+			LoadBalancerResourceId:     pointer.To(v.LoadBalancerId),
+			ProbePort:                  pointer.To(int64(v.ProbePort)),
+			SqlVirtualMachineInstances: pointer.To(v.SqlVirtualMachineIds),
+		}
+
+		if len(v.PrivateIpAddress) > 0 {
+			configuration.PrivateIPAddress = &availabilitygrouplisteners.PrivateIPAddress{
+				IPAddress:        pointer.To(v.PrivateIpAddress[0].IpAddress),
+				SubnetResourceId: pointer.To(v.PrivateIpAddress[0].SubnetId),
+			}
+		}
+
+		output = append(output, configuration)
+	}
+
+	return &output
+}
+
+func flattenMsSqlVirtualMachineAvailabilityGroupListenerLoadBalancerConfigurations(input *[]availabilitygrouplisteners.LoadBalancerConfiguration) ([]MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer, error) {
+	output := make([]MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		configuration := MsSqlVirtualMachineAvailabilityGroupListenerLoadBalancer{
+			ProbePort: int(pointer.From(v.ProbePort)),
+		}
+
+		if v.LoadBalancerResourceId != nil {
+			loadBalancerId, err := loadBalancerParse.LoadBalancerIDInsensitively(*v.LoadBalancerResourceId)
+			if err != nil {
+				return nil, err
+			}
+			configuration.LoadBalancerId = loadBalancerId.ID()
+		}
+
+		if v.PrivateIPAddress != nil {
+			privateIpAddress := MsSqlVirtualMachineAvailabilityGroupListenerPrivateIp{
+				IpAddress: pointer.From(v.PrivateIPAddress.IPAddress),
+			}
+
+			if v.PrivateIPAddress.SubnetResourceId != nil {
+				subnetId, err := networkParse.SubnetIDInsensitively(*v.PrivateIPAddress.SubnetResourceId)
+				if err != nil {
+					return nil, err
+				}
+				privateIpAddress.SubnetId = subnetId.ID()
+			}
+
+			configuration.PrivateIpAddress = []MsSqlVirtualMachineAvailabilityGroupListenerPrivateIp{privateIpAddress}
+		}
+
+		sqlVirtualMachineIds := make([]string, 0)
+		if v.SqlVirtualMachineInstances != nil {
+			for _, sqlVirtualMachineId := range *v.SqlVirtualMachineInstances {
+				id, err := sqlvirtualmachines.ParseSqlVirtualMachineIDInsensitively(sqlVirtualMachineId)
+				if err != nil {
+					return nil, err
+				}
+				sqlVirtualMachineIds = append(sqlVirtualMachineIds, id.ID())
+			}
+		}
+		configuration.SqlVirtualMachineIds = sqlVirtualMachineIds
+
+		output = append(output, configuration)
+	}
+
+	return output, nil
+}
+
+func expandMsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIpConfigurations(input []MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp) *[]availabilitygrouplisteners.MultiSubnetIPConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make([]availabilitygrouplisteners.MultiSubnetIPConfiguration, 0)
+	for _, v := range input {
+		output = append(output, availabilitygrouplisteners.MultiSubnetIPConfiguration{
+			PrivateIPAddress: availabilitygrouplisteners.PrivateIPAddress{
+				IPAddress:        pointer.To(v.PrivateIpAddress),
+				SubnetResourceId: pointer.To(v.SubnetId),
+			},
+			SqlVirtualMachineInstance: v.SqlVirtualMachineId,
+		})
+	}
+
+	return &output
+}
+
+func flattenMsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIpConfigurations(input *[]availabilitygrouplisteners.MultiSubnetIPConfiguration) ([]MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp, error) {
+	output := make([]MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		sqlVirtualMachineId, err := sqlvirtualmachines.ParseSqlVirtualMachineIDInsensitively(v.SqlVirtualMachineInstance)
+		if err != nil {
+			return nil, err
+		}
+
+		configuration := MsSqlVirtualMachineAvailabilityGroupListenerMultiSubnetIp{
+			PrivateIpAddress:    pointer.From(v.PrivateIPAddress.IPAddress),
+			SqlVirtualMachineId: sqlVirtualMachineId.ID(),
+		}
+
+		if v.PrivateIPAddress.SubnetResourceId != nil {
+			subnetId, err := networkParse.SubnetIDInsensitively(*v.PrivateIPAddress.SubnetResourceId)
+			if err != nil {
+				return nil, err
+			}
+			configuration.SubnetId = subnetId.ID()
+		}
+
+		output = append(output, configuration)
+	}
+
+	return output, nil
+}
+
+func expandMsSqlVirtualMachineAvailabilityGroupListenerReplicas(input []MsSqlVirtualMachineAvailabilityGroupListenerReplica) *[]availabilitygrouplisteners.AgReplica {
+	output := make([]availabilitygrouplisteners.AgReplica, 0)
+	for _, v := range input {
+		commit := availabilitygrouplisteners.Commit(v.Commit)
+		failover := availabilitygrouplisteners.Failover(v.FailoverMode)
+		readableSecondary := availabilitygrouplisteners.ReadableSecondary(v.ReadableSecondary)
+		role := availabilitygrouplisteners.Role(v.Role)
+
+		output = append(output, availabilitygrouplisteners.AgReplica{
+			Commit:                      &commit,
+			Failover:                    &failover,
+			ReadableSecondary:           &readableSecondary,
+			Role:                        &role,
+			SqlVirtualMachineInstanceId: pointer.To(v.SqlVirtualMachineId),
+		})
+	}
+
+	return &output
+}
+
+func flattenMsSqlVirtualMachineAvailabilityGroupListenerReplicas(input *availabilitygrouplisteners.AgConfiguration) ([]MsSqlVirtualMachineAvailabilityGroupListenerReplica, error) {
+	output := make([]MsSqlVirtualMachineAvailabilityGroupListenerReplica, 0)
+	if input == nil || input.Replicas == nil {
+		return output, nil
+	}
+
+	for _, v := range *input.Replicas {
+		replica := MsSqlVirtualMachineAvailabilityGroupListenerReplica{}
+
+		if v.SqlVirtualMachineInstanceId != nil {
+			sqlVirtualMachineId, err := sqlvirtualmachines.ParseSqlVirtualMachineIDInsensitively(*v.SqlVirtualMachineInstanceId)
+			if err != nil {
+				return nil, err
+			}
+			replica.SqlVirtualMachineId = sqlVirtualMachineId.ID()
+		}
+
+		if v.Commit != nil {
+			replica.Commit = string(*v.Commit)
+		}
+
+		if v.Failover != nil {
+			replica.FailoverMode = string(*v.Failover)
+		}
+
+		if v.ReadableSecondary != nil {
+			replica.ReadableSecondary = string(*v.ReadableSecondary)
+		}
+
+		if v.Role != nil {
+			replica.Role = string(*v.Role)
+		}
+
+		output = append(output, replica)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,408 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/availabilitygrouplisteners"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlVirtualMachineAvailabilityGroupListenerResource struct{}
+
+func TestAccMsSqlVirtualMachineAvailabilityGroupListener_loadBalancer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_availability_group_listener", "test")
+	r := MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.loadBalancer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlVirtualMachineAvailabilityGroupListener_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_availability_group_listener", "test")
+	r := MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.loadBalancer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlVirtualMachineAvailabilityGroupListener_multiSubnet(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_availability_group_listener", "test")
+	r := MsSqlVirtualMachineAvailabilityGroupListenerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiSubnet(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlVirtualMachineAvailabilityGroupListenerResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := availabilitygrouplisteners.ParseAvailabilityGroupListenerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.VirtualMachinesAvailabilityGroupListenersClient.Get(ctx, *id, availabilitygrouplisteners.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+// template provisions a domain controller and two SQL Server Virtual Machines joined to the domain,
+// since the Windows Server Failover Cluster backing the group requires a domain
+func (MsSqlVirtualMachineAvailabilityGroupListenerResource) template(data acceptance.TestData, clusterSubnetType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+locals {
+  domain_name    = "testdomain.com"
+  admin_username = "testadmin"
+  admin_password = "P@55w0rd1234!"
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-VN-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "domain_controller" {
+  name                 = "acctest-SN-dc-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.0.0/24"]
+}
+
+resource "azurerm_subnet" "test" {
+  count                = 2
+  name                 = "acctest-SN-%[1]d-${count.index}"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.${count.index + 1}.0/24"]
+}
+
+resource "azurerm_network_interface" "domain_controller" {
+  name                = "acctest-NIC-dc-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.domain_controller.id
+    private_ip_address_allocation = "Static"
+    private_ip_address            = "10.0.0.10"
+  }
+}
+
+resource "azurerm_windows_virtual_machine" "domain_controller" {
+  name                  = "acctestdc%[3]s"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  size                  = "Standard_F2s"
+  admin_username        = local.admin_username
+  admin_password        = local.admin_password
+  network_interface_ids = [azurerm_network_interface.domain_controller.id]
+
+  os_disk {
+    caching              = "ReadWrite"
+    storage_account_type = "Standard_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_extension" "domain_controller" {
+  name                 = "create-active-directory-forest"
+  virtual_machine_id   = azurerm_windows_virtual_machine.domain_controller.id
+  publisher            = "Microsoft.Compute"
+  type                 = "CustomScriptExtension"
+  type_handler_version = "1.9"
+
+  settings = jsonencode({
+    commandToExecute = "powershell.exe -Command \"Install-WindowsFeature -Name AD-Domain-Services -IncludeManagementTools; Install-ADDSForest -DomainName ${local.domain_name} -InstallDNS -SafeModeAdministratorPassword (ConvertTo-SecureString '${local.admin_password}' -AsPlainText -Force) -Force; shutdown -r -t 10; exit 0\""
+  })
+}
+
+resource "azurerm_virtual_network_dns_servers" "test" {
+  virtual_network_id = azurerm_virtual_network.test.id
+  dns_servers        = [azurerm_network_interface.domain_controller.private_ip_address]
+
+  depends_on = [azurerm_virtual_machine_extension.domain_controller]
+}
+
+resource "azurerm_network_interface" "test" {
+  count               = 2
+  name                = "acctest-NIC-%[1]d-${count.index}"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test[%[4]s].id
+    private_ip_address_allocation = "Dynamic"
+  }
+
+  depends_on = [azurerm_virtual_network_dns_servers.test]
+}
+
+resource "azurerm_availability_set" "test" {
+  name                         = "acctest-avset-%[1]d"
+  location                     = azurerm_resource_group.test.location
+  resource_group_name          = azurerm_resource_group.test.name
+  platform_update_domain_count = 2
+  platform_fault_domain_count  = 2
+}
+
+resource "azurerm_windows_virtual_machine" "test" {
+  count                 = 2
+  name                  = "acctestvm${count.index}%[3]s"
+  resource_group_name   = azurerm_resource_group.test.name
+  location              = azurerm_resource_group.test.location
+  size                  = "Standard_F2s"
+  admin_username        = local.admin_username
+  admin_password        = local.admin_password
+  availability_set_id   = azurerm_availability_set.test.id
+  network_interface_ids = [azurerm_network_interface.test[count.index].id]
+
+  os_disk {
+    caching              = "ReadOnly"
+    storage_account_type = "Premium_LRS"
+  }
+
+  source_image_reference {
+    publisher = "MicrosoftSQLServer"
+    offer     = "SQL2017-WS2016"
+    sku       = "SQLDEV"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_extension" "join_domain" {
+  count                = 2
+  name                 = "join-domain"
+  virtual_machine_id   = azurerm_windows_virtual_machine.test[count.index].id
+  publisher            = "Microsoft.Compute"
+  type                 = "JsonADDomainExtension"
+  type_handler_version = "1.3"
+
+  settings = jsonencode({
+    Name    = local.domain_name
+    User    = "${local.domain_name}\\${local.admin_username}"
+    Restart = "true"
+    Options = "3"
+  })
+
+  protected_settings = jsonencode({
+    Password = local.admin_password
+  })
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_mssql_virtual_machine_group" "test" {
+  name                = "acctestgr-%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sql_image_offer     = "SQL2017-WS2016"
+  sql_image_sku       = "Developer"
+
+  wsfc_domain_profile {
+    fqdn                           = local.domain_name
+    cluster_subnet_type            = "%[5]s"
+    cluster_bootstrap_account_name = "${local.admin_username}@${local.domain_name}"
+    cluster_operator_account_name  = "${local.admin_username}@${local.domain_name}"
+    sql_service_account_name       = "${local.admin_username}@${local.domain_name}"
+    storage_account_url            = azurerm_storage_account.test.primary_blob_endpoint
+    storage_account_primary_key    = azurerm_storage_account.test.primary_access_key
+  }
+}
+
+resource "azurerm_mssql_virtual_machine" "test" {
+  count                        = 2
+  virtual_machine_id           = azurerm_windows_virtual_machine.test[count.index].id
+  sql_license_type             = "PAYG"
+  sql_virtual_machine_group_id = azurerm_mssql_virtual_machine_group.test.id
+
+  wsfc_domain_credential {
+    cluster_bootstrap_account_password = local.admin_password
+    cluster_operator_account_password  = local.admin_password
+    sql_service_account_password       = local.admin_password
+  }
+
+  depends_on = [azurerm_virtual_machine_extension.join_domain]
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, subnetIndex(clusterSubnetType), clusterSubnetType)
+}
+
+// subnetIndex places both SQL Server Virtual Machines in the first subnet for a SingleSubnet cluster,
+// and each in their own subnet for a MultiSubnet cluster
+func subnetIndex(clusterSubnetType string) string {
+	if clusterSubnetType == "MultiSubnet" {
+		return "count.index"
+	}
+	return "0"
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) loadBalancer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_lb" "test" {
+  name                = "acctest-lb-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                          = "primary"
+    subnet_id                     = azurerm_subnet.test[0].id
+    private_ip_address_allocation = "Static"
+    private_ip_address            = "10.0.1.100"
+  }
+}
+
+resource "azurerm_mssql_virtual_machine_availability_group_listener" "test" {
+  name                         = "acctestli-%[3]s"
+  sql_virtual_machine_group_id = azurerm_mssql_virtual_machine_group.test.id
+  availability_group_name      = "availabilitygroup1"
+  port                         = 1433
+
+  load_balancer_configuration {
+    load_balancer_id        = azurerm_lb.test.id
+    probe_port              = 51572
+    sql_virtual_machine_ids = azurerm_mssql_virtual_machine.test[*].id
+
+    private_ip_address {
+      ip_address = "10.0.1.100"
+      subnet_id  = azurerm_subnet.test[0].id
+    }
+  }
+
+  replica {
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[0].id
+    role                   = "PRIMARY"
+    commit                 = "SYNCHRONOUS_COMMIT"
+    failover_mode          = "AUTOMATIC"
+    readable_secondary     = "ALL"
+  }
+
+  replica {
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[1].id
+    role                   = "SECONDARY"
+    commit                 = "ASYNCHRONOUS_COMMIT"
+    failover_mode          = "MANUAL"
+    readable_secondary     = "NO"
+  }
+}
+`, r.template(data, "SingleSubnet"), data.RandomInteger, data.RandomString)
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_virtual_machine_availability_group_listener" "import" {
+  name                         = azurerm_mssql_virtual_machine_availability_group_listener.test.name
+  sql_virtual_machine_group_id = azurerm_mssql_virtual_machine_availability_group_listener.test.sql_virtual_machine_group_id
+  availability_group_name      = azurerm_mssql_virtual_machine_availability_group_listener.test.availability_group_name
+  port                         = azurerm_mssql_virtual_machine_availability_group_listener.test.port
+
+  load_balancer_configuration {
+    load_balancer_id        = azurerm_lb.test.id
+    probe_port              = 51572
+    sql_virtual_machine_ids = azurerm_mssql_virtual_machine.test[*].id
+
+    private_ip_address {
+      ip_address = "10.0.1.100"
+      subnet_id  = azurerm_subnet.test[0].id
+    }
+  }
+}
+`, r.loadBalancer(data))
+}
+
+func (r MsSqlVirtualMachineAvailabilityGroupListenerResource) multiSubnet(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_virtual_machine_availability_group_listener" "test" {
+  name                         = "acctestli-%[2]s"
+  sql_virtual_machine_group_id = azurerm_mssql_virtual_machine_group.test.id
+  availability_group_name      = "availabilitygroup1"
+  port                         = 1433
+
+  multi_subnet_ip_configuration {
+    private_ip_address     = "10.0.1.100"
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[0].id
+    subnet_id              = azurerm_subnet.test[0].id
+  }
+
+  multi_subnet_ip_configuration {
+    private_ip_address     = "10.0.2.100"
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[1].id
+    subnet_id              = azurerm_subnet.test[1].id
+  }
+
+  replica {
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[0].id
+    role                   = "PRIMARY"
+    commit                 = "SYNCHRONOUS_COMMIT"
+    failover_mode          = "AUTOMATIC"
+    readable_secondary     = "ALL"
+  }
+
+  replica {
+    sql_virtual_machine_id = azurerm_mssql_virtual_machine.test[1].id
+    role                   = "SECONDARY"
+    commit                 = "SYNCHRONOUS_COMMIT"
+    failover_mode          = "AUTOMATIC"
+    readable_secondary     = "ALL"
+  }
+}
+`, r.template(data, "MultiSubnet"), data.RandomString)
+}
@@ -0,0 +1,363 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlVirtualMachineGroupModel struct {
+	Name              string                                      `tfschema:"name"`
+	ResourceGroupName string                                      `tfschema:"resource_group_name"`
+	Location          string                                      `tfschema:"location"`
+	SqlImageOffer     string                                      `tfschema:"sql_image_offer"`
+	SqlImageSku       string                                      `tfschema:"sql_image_sku"`
+	WsfcDomainProfile []MsSqlVirtualMachineGroupWsfcDomainProfile `tfschema:"wsfc_domain_profile"`
+	Tags              map[string]string                           `tfschema:"tags"`
+}
+
+type MsSqlVirtualMachineGroupWsfcDomainProfile struct {
+	Fqdn                        string `tfschema:"fqdn"`
+	ClusterSubnetType           string `tfschema:"cluster_subnet_type"`
+	ClusterBootstrapAccountName string `tfschema:"cluster_bootstrap_account_name"`
+	ClusterOperatorAccountName  string `tfschema:"cluster_operator_account_name"`
+	SqlServiceAccountName       string `tfschema:"sql_service_account_name"`
+	OrganizationalUnitPath      string `tfschema:"organizational_unit_path"`
+	StorageAccountUrl           string `tfschema:"storage_account_url"`
+	StorageAccountPrimaryKey    string `tfschema:"storage_account_primary_key"`
+}
+
+type MsSqlVirtualMachineGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = MsSqlVirtualMachineGroupResource{}
+
+func (r MsSqlVirtualMachineGroupResource) ResourceType() string {
+	return "azurerm_mssql_virtual_machine_group"
+}
+
+func (r MsSqlVirtualMachineGroupResource) ModelObject() interface{} {
+	return &MsSqlVirtualMachineGroupModel{}
+}
+
+func (r MsSqlVirtualMachineGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sqlvirtualmachinegroups.ValidateSqlVirtualMachineGroupID
+}
+
+func (r MsSqlVirtualMachineGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			// the name is used as the name of the Windows Server Failover Cluster, which is a NetBIOS name
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{0,13}[a-zA-Z0-9]$|^[a-zA-Z0-9]$`),
+				"`name` must be between 1 and 15 characters long, contain only letters, numbers and hyphens and must not start or end with a hyphen",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"sql_image_offer": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sql_image_sku": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(sqlvirtualmachinegroups.PossibleValuesForSqlVMGroupImageSku(), false),
+		},
+
+		"wsfc_domain_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"fqdn": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"cluster_subnet_type": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringInSlice(sqlvirtualmachinegroups.PossibleValuesForClusterSubnetType(), false),
+					},
+
+					"cluster_bootstrap_account_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"cluster_operator_account_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sql_service_account_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"organizational_unit_path": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"storage_account_url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+						RequiredWith: []string{"wsfc_domain_profile.0.storage_account_primary_key"},
+					},
+
+					"storage_account_primary_key": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"wsfc_domain_profile.0.storage_account_url"},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MsSqlVirtualMachineGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlVirtualMachineGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model MsSqlVirtualMachineGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.MSSQL.VirtualMachineGroupsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := sqlvirtualmachinegroups.NewSqlVirtualMachineGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sqlImageSku := sqlvirtualmachinegroups.SqlVMGroupImageSku(model.SqlImageSku)
+			parameters := sqlvirtualmachinegroups.SqlVirtualMachineGroup{
+				Location: location.Normalize(model.Location),
+				Properties: &sqlvirtualmachinegroups.SqlVirtualMachineGroupProperties{
+					SqlImageOffer:     pointer.To(model.SqlImageOffer),
+					SqlImageSku:       &sqlImageSku,
+					WsfcDomainProfile: expandMsSqlVirtualMachineGroupWsfcDomainProfile(model.WsfcDomainProfile),
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualMachineGroupsClient
+
+			id, err := sqlvirtualmachinegroups.ParseSqlVirtualMachineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlVirtualMachineGroupModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters := sqlvirtualmachinegroups.SqlVirtualMachineGroupUpdate{
+					Tags: pointer.To(model.Tags),
+				}
+
+				if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualMachineGroupsClient
+
+			id, err := sqlvirtualmachinegroups.ParseSqlVirtualMachineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := MsSqlVirtualMachineGroupModel{
+				Name:              id.SqlVirtualMachineGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.SqlImageOffer = pointer.From(props.SqlImageOffer)
+					if props.SqlImageSku != nil {
+						state.SqlImageSku = string(*props.SqlImageSku)
+					}
+
+					// the storage account key isn't returned by the API, so it's retained from the configuration
+					storageAccountPrimaryKey := metadata.ResourceData.Get("wsfc_domain_profile.0.storage_account_primary_key").(string)
+					state.WsfcDomainProfile = flattenMsSqlVirtualMachineGroupWsfcDomainProfile(props.WsfcDomainProfile, storageAccountPrimaryKey)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlVirtualMachineGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualMachineGroupsClient
+
+			id, err := sqlvirtualmachinegroups.ParseSqlVirtualMachineGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMsSqlVirtualMachineGroupWsfcDomainProfile(input []MsSqlVirtualMachineGroupWsfcDomainProfile) *sqlvirtualmachinegroups.WsfcDomainProfile {
+	if len(input) == 0 {
+		return nil
+	}
+	v := input[0]
+
+	clusterSubnetType := sqlvirtualmachinegroups.ClusterSubnetType(v.ClusterSubnetType)
+	output := sqlvirtualmachinegroups.WsfcDomainProfile{
+		DomainFqdn:        pointer.To(v.Fqdn),
+		ClusterSubnetType: &clusterSubnetType,
+	}
+
+	if v.ClusterBootstrapAccountName != "" {
+		output.ClusterBootstrapAccount = pointer.To(v.ClusterBootstrapAccountName)
+	}
+
+	if v.ClusterOperatorAccountName != "" {
+		output.ClusterOperatorAccount = pointer.To(v.ClusterOperatorAccountName)
+	}
+
+	if v.SqlServiceAccountName != "" {
+		output.SqlServiceAccount = pointer.To(v.SqlServiceAccountName)
+	}
+
+	if v.OrganizationalUnitPath != "" {
+		output.OuPath = pointer.To(v.OrganizationalUnitPath)
+	}
+
+	if v.StorageAccountUrl != "" {
+		output.StorageAccountUrl = pointer.To(v.StorageAccountUrl)
+	}
+
+	if v.StorageAccountPrimaryKey != "" {
+		output.StorageAccountPrimaryKey = pointer.To(v.StorageAccountPrimaryKey)
+	}
+
+	return &output
+}
+
+func flattenMsSqlVirtualMachineGroupWsfcDomainProfile(input *sqlvirtualmachinegroups.WsfcDomainProfile, storageAccountPrimaryKey string) []MsSqlVirtualMachineGroupWsfcDomainProfile {
+	if input == nil {
+		return []MsSqlVirtualMachineGroupWsfcDomainProfile{}
+	}
+
+	output := MsSqlVirtualMachineGroupWsfcDomainProfile{
+		Fqdn:                        pointer.From(input.DomainFqdn),
+		ClusterBootstrapAccountName: pointer.From(input.ClusterBootstrapAccount),
+		ClusterOperatorAccountName:  pointer.From(input.ClusterOperatorAccount),
+		SqlServiceAccountName:       pointer.From(input.SqlServiceAccount),
+		OrganizationalUnitPath:      pointer.From(input.OuPath),
+		StorageAccountUrl:           pointer.From(input.StorageAccountUrl),
+		StorageAccountPrimaryKey:    storageAccountPrimaryKey,
+	}
+
+	if input.ClusterSubnetType != nil {
+		output.ClusterSubnetType = string(*input.ClusterSubnetType)
+	}
+
+	return []MsSqlVirtualMachineGroupWsfcDomainProfile{output}
+}
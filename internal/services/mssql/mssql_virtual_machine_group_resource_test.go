@@ -0,0 +1,216 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachinegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlVirtualMachineGroupResource struct{}
+
+func TestAccMsSqlVirtualMachineGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_group", "test")
+	r := MsSqlVirtualMachineGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlVirtualMachineGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_group", "test")
+	r := MsSqlVirtualMachineGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlVirtualMachineGroup_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_group", "test")
+	r := MsSqlVirtualMachineGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wsfc_domain_profile.0.storage_account_primary_key"),
+	})
+}
+
+func TestAccMsSqlVirtualMachineGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_virtual_machine_group", "test")
+	r := MsSqlVirtualMachineGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tagsUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (MsSqlVirtualMachineGroupResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sqlvirtualmachinegroups.ParseSqlVirtualMachineGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.VirtualMachineGroupsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (MsSqlVirtualMachineGroupResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MsSqlVirtualMachineGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_virtual_machine_group" "test" {
+  name                = "acctestgr-%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sql_image_offer     = "SQL2017-WS2016"
+  sql_image_sku       = "Developer"
+
+  wsfc_domain_profile {
+    fqdn                = "testdomain.com"
+    cluster_subnet_type = "SingleSubnet"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MsSqlVirtualMachineGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_virtual_machine_group" "import" {
+  name                = azurerm_mssql_virtual_machine_group.test.name
+  resource_group_name = azurerm_mssql_virtual_machine_group.test.resource_group_name
+  location            = azurerm_mssql_virtual_machine_group.test.location
+  sql_image_offer     = azurerm_mssql_virtual_machine_group.test.sql_image_offer
+  sql_image_sku       = azurerm_mssql_virtual_machine_group.test.sql_image_sku
+
+  wsfc_domain_profile {
+    fqdn                = "testdomain.com"
+    cluster_subnet_type = "SingleSubnet"
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlVirtualMachineGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[2]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_mssql_virtual_machine_group" "test" {
+  name                = "acctestgr-%[2]s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sql_image_offer     = "SQL2017-WS2016"
+  sql_image_sku       = "Developer"
+
+  wsfc_domain_profile {
+    fqdn                           = "testdomain.com"
+    cluster_subnet_type            = "SingleSubnet"
+    cluster_bootstrap_account_name = "install@testdomain.com"
+    cluster_operator_account_name  = "install@testdomain.com"
+    sql_service_account_name       = "sqlsvc@testdomain.com"
+    organizational_unit_path       = "OU=test,DC=testdomain,DC=com"
+    storage_account_url            = azurerm_storage_account.test.primary_blob_endpoint
+    storage_account_primary_key    = azurerm_storage_account.test.primary_access_key
+  }
+
+  tags = {
+    environment = "test"
+  }
+}
+`, r.template(data), data.RandomString)
+}
+
+func (r MsSqlVirtualMachineGroupResource) tagsUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_virtual_machine_group" "test" {
+  name                = "acctestgr-%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sql_image_offer     = "SQL2017-WS2016"
+  sql_image_sku       = "Developer"
+
+  wsfc_domain_profile {
+    fqdn                = "testdomain.com"
+    cluster_subnet_type = "SingleSubnet"
+  }
+
+  tags = {
+    environment = "production"
+  }
+}
+`, r.template(data), data.RandomString)
+}
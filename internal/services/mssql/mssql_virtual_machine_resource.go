@@ -427,6 +427,43 @@ func resourceMsSqlVirtualMachine() *pluginsdk.Resource {
 				},
 			},
 
+			"sql_virtual_machine_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: sqlvirtualmachines.ValidateSqlVirtualMachineGroupID,
+			},
+
+			"wsfc_domain_credential": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"sql_virtual_machine_group_id"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"cluster_bootstrap_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"cluster_operator_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"sql_service_account_password": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -528,6 +565,14 @@ func resourceMsSqlVirtualMachineCreateUpdate(d *pluginsdk.ResourceData, meta int
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("sql_virtual_machine_group_id"); ok {
+		parameters.Properties.SqlVirtualMachineGroupResourceId = utils.String(v.(string))
+		parameters.Properties.WsfcDomainCredentials = expandSqlVirtualMachineWsfcDomainCredentials(d.Get("wsfc_domain_credential").([]interface{}))
+	} else if !d.IsNewResource() && d.HasChange("sql_virtual_machine_group_id") {
+		// the SQL Virtual Machine is only removed from the SQL Virtual Machine Group when the ID is explicitly cleared
+		parameters.Properties.SqlVirtualMachineGroupResourceId = utils.String("")
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -645,6 +690,16 @@ func resourceMsSqlVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			if err := d.Set("storage_configuration", flattenSqlVirtualMachineStorageConfigurationSettings(props.StorageConfigurationSettings, storageWorkloadType)); err != nil {
 				return fmt.Errorf("setting `storage_configuration`: %+v", err)
 			}
+
+			sqlVirtualMachineGroupId := ""
+			if props.SqlVirtualMachineGroupResourceId != nil && *props.SqlVirtualMachineGroupResourceId != "" {
+				groupId, err := sqlvirtualmachines.ParseSqlVirtualMachineGroupIDInsensitively(*props.SqlVirtualMachineGroupResourceId)
+				if err != nil {
+					return err
+				}
+				sqlVirtualMachineGroupId = groupId.ID()
+			}
+			d.Set("sql_virtual_machine_group_id", sqlVirtualMachineGroupId)
 			if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 				return err
 			}
@@ -1372,3 +1427,16 @@ func flattenSqlVirtualMachineSQLInstance(input *sqlvirtualmachines.SQLInstanceSe
 		},
 	}
 }
+
+func expandSqlVirtualMachineWsfcDomainCredentials(input []interface{}) *sqlvirtualmachines.WsfcDomainCredentials {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	return &sqlvirtualmachines.WsfcDomainCredentials{
+		ClusterBootstrapAccountPassword: utils.String(v["cluster_bootstrap_account_password"].(string)),
+		ClusterOperatorAccountPassword:  utils.String(v["cluster_operator_account_password"].(string)),
+		SqlServiceAccountPassword:       utils.String(v["sql_service_account_password"].(string)),
+	}
+}
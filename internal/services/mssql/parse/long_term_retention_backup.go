@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type LongTermRetentionBackupId struct {
+	SubscriptionId                string
+	ResourceGroup                 string
+	LocationName                  string
+	LongTermRetentionServerName   string
+	LongTermRetentionDatabaseName string
+	Name                          string
+}
+
+func NewLongTermRetentionBackupID(subscriptionId, resourceGroup, locationName, longTermRetentionServerName, longTermRetentionDatabaseName, name string) LongTermRetentionBackupId {
+	return LongTermRetentionBackupId{
+		SubscriptionId:                subscriptionId,
+		ResourceGroup:                 resourceGroup,
+		LocationName:                  locationName,
+		LongTermRetentionServerName:   longTermRetentionServerName,
+		LongTermRetentionDatabaseName: longTermRetentionDatabaseName,
+		Name:                          name,
+	}
+}
+
+func (id LongTermRetentionBackupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Long Term Retention Database Name %q", id.LongTermRetentionDatabaseName),
+		fmt.Sprintf("Long Term Retention Server Name %q", id.LongTermRetentionServerName),
+		fmt.Sprintf("Location Name %q", id.LocationName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Long Term Retention Backup", segmentsStr)
+}
+
+func (id LongTermRetentionBackupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Sql/locations/%s/longTermRetentionServers/%s/longTermRetentionDatabases/%s/longTermRetentionBackups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LocationName, id.LongTermRetentionServerName, id.LongTermRetentionDatabaseName, id.Name)
+}
+
+// LongTermRetentionBackupID parses a LongTermRetentionBackup ID into an LongTermRetentionBackupId struct
+func LongTermRetentionBackupID(input string) (*LongTermRetentionBackupId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := LongTermRetentionBackupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.LocationName, err = id.PopSegment("locations"); err != nil {
+		return nil, err
+	}
+	if resourceId.LongTermRetentionServerName, err = id.PopSegment("longTermRetentionServers"); err != nil {
+		return nil, err
+	}
+	if resourceId.LongTermRetentionDatabaseName, err = id.PopSegment("longTermRetentionDatabases"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("longTermRetentionBackups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,160 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = LongTermRetentionBackupId{}
+
+func TestLongTermRetentionBackupIDFormatter(t *testing.T) {
+	actual := NewLongTermRetentionBackupID("12345678-1234-9876-4563-123456789012", "group1", "westeurope", "server1", "database1", "backup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/longTermRetentionDatabases/database1/longTermRetentionBackups/backup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLongTermRetentionBackupID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *LongTermRetentionBackupId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// missing ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+			Error: true,
+		},
+
+		{
+			// missing value for ResourceGroup
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/",
+			Error: true,
+		},
+
+		{
+			// missing LocationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/",
+			Error: true,
+		},
+
+		{
+			// missing value for LocationName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/",
+			Error: true,
+		},
+
+		{
+			// missing LongTermRetentionServerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/",
+			Error: true,
+		},
+
+		{
+			// missing value for LongTermRetentionServerName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/",
+			Error: true,
+		},
+
+		{
+			// missing LongTermRetentionDatabaseName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/",
+			Error: true,
+		},
+
+		{
+			// missing value for LongTermRetentionDatabaseName
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/longTermRetentionDatabases/",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/longTermRetentionDatabases/database1/",
+			Error: true,
+		},
+
+		{
+			// missing value for Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/longTermRetentionDatabases/database1/longTermRetentionBackups/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/locations/westeurope/longTermRetentionServers/server1/longTermRetentionDatabases/database1/longTermRetentionBackups/backup1",
+			Expected: &LongTermRetentionBackupId{
+				SubscriptionId:                "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                 "group1",
+				LocationName:                  "westeurope",
+				LongTermRetentionServerName:   "server1",
+				LongTermRetentionDatabaseName: "database1",
+				Name:                          "backup1",
+			},
+		},
+
+		{
+			// upper-cased
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/LOCATIONS/WESTEUROPE/LONGTERMRETENTIONSERVERS/SERVER1/LONGTERMRETENTIONDATABASES/DATABASE1/LONGTERMRETENTIONBACKUPS/BACKUP1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := LongTermRetentionBackupID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.SubscriptionId != v.Expected.SubscriptionId {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", v.Expected.SubscriptionId, actual.SubscriptionId)
+		}
+		if actual.ResourceGroup != v.Expected.ResourceGroup {
+			t.Fatalf("Expected %q but got %q for ResourceGroup", v.Expected.ResourceGroup, actual.ResourceGroup)
+		}
+		if actual.LocationName != v.Expected.LocationName {
+			t.Fatalf("Expected %q but got %q for LocationName", v.Expected.LocationName, actual.LocationName)
+		}
+		if actual.LongTermRetentionServerName != v.Expected.LongTermRetentionServerName {
+			t.Fatalf("Expected %q but got %q for LongTermRetentionServerName", v.Expected.LongTermRetentionServerName, actual.LongTermRetentionServerName)
+		}
+		if actual.LongTermRetentionDatabaseName != v.Expected.LongTermRetentionDatabaseName {
+			t.Fatalf("Expected %q but got %q for LongTermRetentionDatabaseName", v.Expected.LongTermRetentionDatabaseName, actual.LongTermRetentionDatabaseName)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
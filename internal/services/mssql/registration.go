@@ -31,9 +31,11 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_mssql_database":    dataSourceMsSqlDatabase(),
-		"azurerm_mssql_elasticpool": dataSourceMsSqlElasticpool(),
-		"azurerm_mssql_server":      dataSourceMsSqlServer(),
+		"azurerm_mssql_database":                             dataSourceMsSqlDatabase(),
+		"azurerm_mssql_database_long_term_retention_backups": dataSourceMsSqlDatabaseLongTermRetentionBackups(),
+		"azurerm_mssql_database_restorable_timestamps":       dataSourceMsSqlDatabaseRestorableTimestamps(),
+		"azurerm_mssql_elasticpool":                          dataSourceMsSqlElasticpool(),
+		"azurerm_mssql_server":                               dataSourceMsSqlServer(),
 	}
 }
 
@@ -45,8 +47,11 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
+		"azurerm_mssql_job":                                             resourceMsSqlJob(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
+		"azurerm_mssql_job_step":                                        resourceMsSqlJobStep(),
+		"azurerm_mssql_job_target_group":                                resourceMsSqlJobTargetGroup(),
 		"azurerm_mssql_managed_instance_security_alert_policy":          resourceMsSqlManagedInstanceSecurityAlertPolicy(),
 		"azurerm_mssql_managed_instance_transparent_data_encryption":    resourceMsSqlManagedInstanceTransparentDataEncryption(),
 		"azurerm_mssql_managed_instance_vulnerability_assessment":       resourceMsSqlManagedInstanceVulnerabilityAssessment(),
@@ -77,6 +82,8 @@ func (r Registration) Resources() []sdk.Resource {
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
 		MsSqlManagedInstanceResource{},
+		MsSqlVirtualMachineAvailabilityGroupListenerResource{},
+		MsSqlVirtualMachineGroupResource{},
 		ServerDNSAliasResource{},
 	}
 }
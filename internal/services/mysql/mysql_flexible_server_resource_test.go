@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -276,6 +277,25 @@ func TestAccMySqlFlexibleServer_pitr(t *testing.T) {
 	})
 }
 
+func TestAccMySqlFlexibleServer_pitrOutsideRestoreWindow(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config:      r.pitrOutsideRestoreWindow(data),
+			ExpectError: regexp.MustCompile("is before the earliest restore time"),
+		},
+	})
+}
+
 func TestAccMySqlFlexibleServer_replica(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
 	r := MySqlFlexibleServerResource{}
@@ -849,6 +869,22 @@ resource "azurerm_mysql_flexible_server" "pitr" {
 `, r.basic(data), data.RandomInteger, time.Now().Add(time.Duration(15)*time.Minute).UTC().Format(time.RFC3339))
 }
 
+func (r MySqlFlexibleServerResource) pitrOutsideRestoreWindow(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server" "pitr" {
+  name                              = "acctest-fs-pitr-%d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  create_mode                       = "PointInTimeRestore"
+  source_server_id                  = azurerm_mysql_flexible_server.test.id
+  point_in_time_restore_time_in_utc = "%s"
+  zone                              = "1"
+}
+`, r.basic(data), data.RandomInteger, time.Now().Add(-24*time.Hour).UTC().Format(time.RFC3339))
+}
+
 func (r MySqlFlexibleServerResource) source(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
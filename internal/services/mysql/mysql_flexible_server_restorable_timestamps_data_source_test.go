@@ -0,0 +1,36 @@
+package mysql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MySqlFlexibleServerRestorableTimestampsDataSource struct{}
+
+func TestAccDataSourceMySqlFlexibleServerRestorableTimestamps_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_mysql_flexible_server_restorable_timestamps", "test")
+	r := MySqlFlexibleServerRestorableTimestampsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("earliest_restore_time").Exists(),
+				check.That(data.ResourceName).Key("latest_restore_time").Exists(),
+			),
+		},
+	})
+}
+
+func (MySqlFlexibleServerRestorableTimestampsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_mysql_flexible_server_restorable_timestamps" "test" {
+  server_id = azurerm_mysql_flexible_server.test.id
+}
+`, MySqlFlexibleServerResource{}.basic(data))
+}
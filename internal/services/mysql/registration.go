@@ -28,8 +28,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_mysql_server":          dataSourceMySqlServer(),
-		"azurerm_mysql_flexible_server": dataSourceMysqlFlexibleServer(),
+		"azurerm_mysql_server":                                dataSourceMySqlServer(),
+		"azurerm_mysql_flexible_server":                       dataSourceMysqlFlexibleServer(),
+		"azurerm_mysql_flexible_server_restorable_timestamps": dataSourceMysqlFlexibleServerRestorableTimestamps(),
 	}
 }
 
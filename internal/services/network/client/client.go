@@ -28,7 +28,9 @@ type Client struct {
 	ManagersClient                           *network.ManagersClient
 	ManagerAdminRulesClient                  *network.AdminRulesClient
 	ManagerAdminRuleCollectionsClient        *network.AdminRuleCollectionsClient
+	ManagerCommitsClient                     *network.ManagerCommitsClient
 	ManagerConnectivityConfigurationsClient  *network.ConnectivityConfigurationsClient
+	ManagerDeploymentStatusClient            *network.ManagerDeploymentStatusClient
 	ManagerManagementGroupConnectionsClient  *network.ManagementGroupNetworkManagerConnectionsClient
 	ManagerNetworkGroupsClient               *network.GroupsClient
 	ManagerScopeConnectionsClient            *network.ScopeConnectionsClient
@@ -142,9 +144,15 @@ func NewClient(o *common.ClientOptions) *Client {
 	ManagerAdminRuleCollectionsClient := network.NewAdminRuleCollectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagerAdminRuleCollectionsClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagerCommitsClient := network.NewManagerCommitsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ManagerCommitsClient.Client, o.ResourceManagerAuthorizer)
+
 	ManagerConnectivityConfigurationsClient := network.NewConnectivityConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagerConnectivityConfigurationsClient.Client, o.ResourceManagerAuthorizer)
 
+	ManagerDeploymentStatusClient := network.NewManagerDeploymentStatusClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ManagerDeploymentStatusClient.Client, o.ResourceManagerAuthorizer)
+
 	ManagerScopeConnectionsClient := network.NewScopeConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ManagerScopeConnectionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -300,7 +308,9 @@ func NewClient(o *common.ClientOptions) *Client {
 		ManagersClient:                           &ManagersClient,
 		ManagerAdminRulesClient:                  &ManagerAdminRulesClient,
 		ManagerAdminRuleCollectionsClient:        &ManagerAdminRuleCollectionsClient,
+		ManagerCommitsClient:                     &ManagerCommitsClient,
 		ManagerConnectivityConfigurationsClient:  &ManagerConnectivityConfigurationsClient,
+		ManagerDeploymentStatusClient:            &ManagerDeploymentStatusClient,
 		ManagerManagementGroupConnectionsClient:  &ManagerManagementGroupConnectionsClient,
 		ManagerNetworkGroupsClient:               &ManagerNetworkGroupsClient,
 		ManagerScopeConnectionsClient:            &ManagerScopeConnectionsClient,
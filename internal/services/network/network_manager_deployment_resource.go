@@ -0,0 +1,437 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+type ManagerDeploymentModel struct {
+	NetworkManagerId string            `tfschema:"network_manager_id"`
+	Locations        []string          `tfschema:"locations"`
+	ScopeAccess      string            `tfschema:"scope_access"`
+	ConfigurationIds []string          `tfschema:"configuration_ids"`
+	Triggers         map[string]string `tfschema:"triggers"`
+}
+
+type ManagerDeploymentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ManagerDeploymentResource{}
+
+func (r ManagerDeploymentResource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentResource) ModelObject() interface{} {
+	return &ManagerDeploymentModel{}
+}
+
+func (r ManagerDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.NetworkManagerDeploymentID
+}
+
+func (r ManagerDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkManagerID,
+		},
+
+		"locations": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:             pluginsdk.TypeString,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+			Set: pluginsdk.HashString,
+		},
+
+		"scope_access": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(network.ConfigurationTypeConnectivity),
+				string(network.ConfigurationTypeSecurityAdmin),
+			}, false),
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.Any(
+					validate.NetworkManagerConnectivityConfigurationID,
+					validate.NetworkManagerSecurityAdminConfigurationID,
+				),
+			},
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagerDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			commitClient := metadata.Client.Network.ManagerCommitsClient
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+			networkManagerId, err := parse.NetworkManagerID(model.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroup, networkManagerId.Name, model.ScopeAccess)
+			locations := normalizeNetworkManagerDeploymentLocations(model.Locations)
+			existing, err := getNetworkManagerDeploymentStatuses(ctx, statusClient, id, locations)
+			if err != nil {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			// a Network Manager with nothing committed to a region still returns a Deployment Status for that region
+			for _, status := range existing {
+				if status.ConfigurationIds != nil && len(*status.ConfigurationIds) != 0 {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			if err := commitNetworkManagerDeployment(ctx, commitClient, statusClient, id, locations, model.ConfigurationIds); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			commitClient := metadata.Client.Network.ManagerCommitsClient
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locations := normalizeNetworkManagerDeploymentLocations(model.Locations)
+
+			if metadata.ResourceData.HasChange("locations") {
+				// the configurations are removed from any locations which are no longer specified by committing an empty set
+				oldLocationsRaw, _ := metadata.ResourceData.GetChange("locations")
+				removedLocations := make([]string, 0)
+				for _, v := range normalizeNetworkManagerDeploymentLocations(*utils.ExpandStringSlice(oldLocationsRaw.(*pluginsdk.Set).List())) {
+					if !utils.SliceContainsValue(locations, v) {
+						removedLocations = append(removedLocations, v)
+					}
+				}
+
+				if len(removedLocations) > 0 {
+					if err := commitNetworkManagerDeployment(ctx, commitClient, statusClient, *id, removedLocations, []string{}); err != nil {
+						return fmt.Errorf("removing the configurations from the locations %q for %s: %+v", strings.Join(removedLocations, ", "), *id, err)
+					}
+				}
+			}
+
+			if metadata.ResourceData.HasChanges("locations", "configuration_ids", "triggers") {
+				if err := commitNetworkManagerDeployment(ctx, commitClient, statusClient, *id, locations, model.ConfigurationIds); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var existingState ManagerDeploymentModel
+			if err := metadata.Decode(&existingState); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// when the resource is imported the locations aren't known, so the Deployment Status for all locations is retrieved
+			existing, err := getNetworkManagerDeploymentStatuses(ctx, statusClient, *id, normalizeNetworkManagerDeploymentLocations(existingState.Locations))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ManagerDeploymentModel{
+				NetworkManagerId: parse.NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName).ID(),
+				Locations:        make([]string, 0),
+				ScopeAccess:      id.ScopeAccess,
+				ConfigurationIds: make([]string, 0),
+				// `triggers` isn't returned by the API, so we look this up from the existing state
+				Triggers: existingState.Triggers,
+			}
+
+			for region, status := range existing {
+				if status.ConfigurationIds == nil || len(*status.ConfigurationIds) == 0 {
+					continue
+				}
+
+				state.Locations = append(state.Locations, region)
+				for _, v := range *status.ConfigurationIds {
+					// the casing of the Configuration IDs returned by the API can differ from those which were committed
+					for _, existingId := range existingState.ConfigurationIds {
+						if strings.EqualFold(existingId, v) {
+							v = existingId
+							break
+						}
+					}
+
+					if !utils.SliceContainsValue(state.ConfigurationIds, v) {
+						state.ConfigurationIds = append(state.ConfigurationIds, v)
+					}
+				}
+			}
+
+			if len(state.Locations) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagerDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			commitClient := metadata.Client.Network.ManagerCommitsClient
+			statusClient := metadata.Client.Network.ManagerDeploymentStatusClient
+
+			id, err := parse.NetworkManagerDeploymentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ManagerDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a Deployment is removed by committing an empty set of configurations to the locations
+			if err := commitNetworkManagerDeployment(ctx, commitClient, statusClient, *id, normalizeNetworkManagerDeploymentLocations(model.Locations), []string{}); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func commitNetworkManagerDeployment(ctx context.Context, commitClient *network.ManagerCommitsClient, statusClient *network.ManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId, locations []string, configurationIds []string) error {
+	input := network.ManagerCommit{
+		TargetLocations:  &locations,
+		ConfigurationIds: &configurationIds,
+		CommitType:       network.ConfigurationType(id.ScopeAccess),
+	}
+
+	future, err := commitClient.Post(ctx, input, id.ResourceGroup, id.NetworkManagerName)
+	if err != nil {
+		return fmt.Errorf("committing: %+v", err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, commitClient.Client); err != nil {
+		return fmt.Errorf("waiting for the commit to be accepted: %+v", err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(network.DeploymentStatusNotStarted),
+			string(network.DeploymentStatusDeploying),
+		},
+		Target: []string{
+			string(network.DeploymentStatusDeployed),
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh:    networkManagerDeploymentStateRefreshFunc(ctx, statusClient, id, locations, configurationIds),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the deployment to complete: %+v", err)
+	}
+
+	return nil
+}
+
+func networkManagerDeploymentStateRefreshFunc(ctx context.Context, client *network.ManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId, locations []string, configurationIds []string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		statuses, err := getNetworkManagerDeploymentStatuses(ctx, client, id, locations)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// the Deployment is only complete once the commit has been deployed to every location
+		for _, region := range locations {
+			status, ok := statuses[region]
+			if !ok {
+				// once an empty set has been committed the Deployment Status may no longer be returned for the location
+				if len(configurationIds) == 0 {
+					continue
+				}
+
+				log.Printf("[DEBUG] No Deployment Status was returned for the location %q of %s yet", region, id)
+				return statuses, string(network.DeploymentStatusNotStarted), nil
+			}
+
+			if status.DeploymentStatus == network.DeploymentStatusFailed {
+				message := ""
+				if status.ErrorMessage != nil {
+					message = *status.ErrorMessage
+				}
+				return statuses, string(status.DeploymentStatus), fmt.Errorf("the deployment to the location %q failed: %s", region, message)
+			}
+
+			if status.DeploymentStatus != network.DeploymentStatusDeployed {
+				return statuses, string(status.DeploymentStatus), nil
+			}
+
+			// the status of the previous commit can be returned until the new commit has been picked up
+			if !networkManagerDeploymentConfigurationIdsMatch(status.ConfigurationIds, configurationIds) {
+				return statuses, string(network.DeploymentStatusNotStarted), nil
+			}
+		}
+
+		return statuses, string(network.DeploymentStatusDeployed), nil
+	}
+}
+
+func networkManagerDeploymentConfigurationIdsMatch(actual *[]string, expected []string) bool {
+	actualIds := make(map[string]struct{})
+	if actual != nil {
+		for _, v := range *actual {
+			actualIds[strings.ToLower(v)] = struct{}{}
+		}
+	}
+
+	expectedIds := make(map[string]struct{})
+	for _, v := range expected {
+		expectedIds[strings.ToLower(v)] = struct{}{}
+	}
+
+	if len(actualIds) != len(expectedIds) {
+		return false
+	}
+
+	for v := range expectedIds {
+		if _, ok := actualIds[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeNetworkManagerDeploymentLocations(input []string) []string {
+	locations := make([]string, 0, len(input))
+	for _, v := range input {
+		locations = append(locations, location.Normalize(v))
+	}
+
+	return locations
+}
+
+// getNetworkManagerDeploymentStatuses returns the Deployment Status for each of the specified locations keyed by the
+// normalized location, when no locations are specified the Deployment Status for all locations is returned
+func getNetworkManagerDeploymentStatuses(ctx context.Context, client *network.ManagerDeploymentStatusClient, id parse.NetworkManagerDeploymentId, locations []string) (map[string]network.ManagerDeploymentStatus, error) {
+	input := network.ManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]network.ConfigurationType{network.ConfigurationType(id.ScopeAccess)},
+	}
+	if len(locations) > 0 {
+		input.Regions = &locations
+	}
+
+	statuses := make(map[string]network.ManagerDeploymentStatus)
+	for {
+		resp, err := client.List(ctx, input, id.ResourceGroup, id.NetworkManagerName, nil)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return statuses, nil
+			}
+
+			return nil, err
+		}
+
+		if resp.Value != nil {
+			for _, status := range *resp.Value {
+				if status.Region == nil || !strings.EqualFold(string(status.DeploymentType), id.ScopeAccess) {
+					continue
+				}
+
+				region := location.Normalize(*status.Region)
+				if len(locations) > 0 && !utils.SliceContainsValue(locations, region) {
+					continue
+				}
+
+				statuses[region] = status
+			}
+		}
+
+		if resp.SkipToken == nil || *resp.SkipToken == "" {
+			break
+		}
+		input.SkipToken = resp.SkipToken
+	}
+
+	return statuses, nil
+}
@@ -0,0 +1,273 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/network/2022-07-01/network"
+)
+
+type ManagerDeploymentResource struct{}
+
+func testAccNetworkManagerDeployment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccNetworkManagerDeployment_securityAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.securityAdmin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_deployment", "test")
+	r := ManagerDeploymentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("locations.#").HasValue("2"),
+				check.That(data.ResourceName).Key("configuration_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep("triggers"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ManagerDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkManagerDeploymentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.Network.ManagerDeploymentStatusClient
+	input := network.ManagerDeploymentStatusParameter{
+		DeploymentTypes: &[]network.ConfigurationType{network.ConfigurationType(id.ScopeAccess)},
+	}
+	resp, err := client.List(ctx, input, id.ResourceGroup, id.NetworkManagerName, nil)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if resp.Value != nil {
+		for _, status := range *resp.Value {
+			if strings.EqualFold(string(status.DeploymentType), id.ScopeAccess) && status.ConfigurationIds != nil && len(*status.ConfigurationIds) > 0 {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r ManagerDeploymentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-network-manager-%d"
+  location = "%s"
+}
+
+data "azurerm_subscription" "current" {
+}
+
+resource "azurerm_network_manager" "test" {
+  name                = "acctest-nm-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  scope {
+    subscription_ids = [data.azurerm_subscription.current.id]
+  }
+  scope_accesses = ["Connectivity", "SecurityAdmin"]
+}
+
+resource "azurerm_network_manager_network_group" "test" {
+  name               = "acctest-nmng-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                    = "acctest-vnet-%d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  address_space           = ["10.0.0.0/16"]
+  flow_timeout_in_minutes = 10
+}
+
+resource "azurerm_network_manager_connectivity_configuration" "test" {
+  name                  = "acctest-nmcc-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "HubAndSpoke"
+  applies_to_group {
+    group_connectivity = "None"
+    network_group_id   = azurerm_network_manager_network_group.test.id
+  }
+  hub {
+    resource_id   = azurerm_virtual_network.test.id
+    resource_type = "Microsoft.Network/virtualNetworks"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagerDeploymentResource) basic(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  locations          = ["eastus"]
+  scope_access       = "Connectivity"
+  configuration_ids  = [azurerm_network_manager_connectivity_configuration.test.id]
+}
+`, template)
+}
+
+func (r ManagerDeploymentResource) requiresImport(data acceptance.TestData) string {
+	config := r.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_deployment" "import" {
+  network_manager_id = azurerm_network_manager_deployment.test.network_manager_id
+  locations          = azurerm_network_manager_deployment.test.locations
+  scope_access       = azurerm_network_manager_deployment.test.scope_access
+  configuration_ids  = azurerm_network_manager_deployment.test.configuration_ids
+}
+`, config)
+}
+
+func (r ManagerDeploymentResource) securityAdmin(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_security_admin_configuration" "test" {
+  name               = "acctest-nmsac-%d"
+  network_manager_id = azurerm_network_manager.test.id
+}
+
+resource "azurerm_network_manager_admin_rule_collection" "test" {
+  name                            = "acctest-nmarc-%d"
+  security_admin_configuration_id = azurerm_network_manager_security_admin_configuration.test.id
+  network_group_ids               = [azurerm_network_manager_network_group.test.id]
+}
+
+resource "azurerm_network_manager_admin_rule" "test" {
+  name                     = "acctest-nmar-%d"
+  admin_rule_collection_id = azurerm_network_manager_admin_rule_collection.test.id
+  action                   = "Deny"
+  direction                = "Outbound"
+  priority                 = 1
+  protocol                 = "Tcp"
+}
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  locations          = ["eastus"]
+  scope_access       = "SecurityAdmin"
+  configuration_ids  = [azurerm_network_manager_security_admin_configuration.test.id]
+  depends_on         = [azurerm_network_manager_admin_rule.test]
+}
+`, template, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
+
+func (r ManagerDeploymentResource) update(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_connectivity_configuration" "test2" {
+  name                  = "acctest-nmcc2-%d"
+  network_manager_id    = azurerm_network_manager.test.id
+  connectivity_topology = "HubAndSpoke"
+  applies_to_group {
+    group_connectivity = "None"
+    network_group_id   = azurerm_network_manager_network_group.test.id
+  }
+  hub {
+    resource_id   = azurerm_virtual_network.test.id
+    resource_type = "Microsoft.Network/virtualNetworks"
+  }
+}
+
+resource "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager.test.id
+  locations          = ["eastus", "westus"]
+  scope_access       = "Connectivity"
+  configuration_ids = [
+    azurerm_network_manager_connectivity_configuration.test.id,
+    azurerm_network_manager_connectivity_configuration.test2.id,
+  ]
+  triggers = {
+    connectivity_topology = azurerm_network_manager_connectivity_configuration.test2.connectivity_topology
+  }
+}
+`, template, data.RandomInteger)
+}
@@ -74,6 +74,12 @@ func TestAccNetworkManager(t *testing.T) {
 			"update":         testAccNetworkManagerAdminRuleCollection_update,
 			"requiresImport": testAccNetworkManagerAdminRuleCollection_requiresImport,
 		},
+		"Deployment": {
+			"basic":          testAccNetworkManagerDeployment_basic,
+			"securityAdmin":  testAccNetworkManagerDeployment_securityAdmin,
+			"update":         testAccNetworkManagerDeployment_update,
+			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
+		},
 		"AdminRule": {
 			"basic":          testAccNetworkManagerAdminRule_basic,
 			"complete":       testAccNetworkManagerAdminRule_complete,
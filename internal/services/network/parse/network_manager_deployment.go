@@ -0,0 +1,69 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = NetworkManagerDeploymentId{}
+
+type NetworkManagerDeploymentId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	NetworkManagerName string
+	ScopeAccess        string
+}
+
+func NewNetworkManagerDeploymentID(subscriptionId, resourceGroup, networkManagerName, scopeAccess string) NetworkManagerDeploymentId {
+	return NetworkManagerDeploymentId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		NetworkManagerName: networkManagerName,
+		ScopeAccess:        scopeAccess,
+	}
+}
+
+func (id NetworkManagerDeploymentId) String() string {
+	segments := []string{
+		fmt.Sprintf("Scope Access %q", id.ScopeAccess),
+		fmt.Sprintf("Network Manager Name %q", id.NetworkManagerName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Network Manager Deployment", segmentsStr)
+}
+
+// ID returns the ID of the Network Manager Deployment, since a Deployment isn't an ARM resource the ID is in the
+// format {networkManagerId}/commit|{scopeAccess}
+func (id NetworkManagerDeploymentId) ID() string {
+	networkManagerId := NewNetworkManagerID(id.SubscriptionId, id.ResourceGroup, id.NetworkManagerName)
+	return fmt.Sprintf("%s/commit|%s", networkManagerId.ID(), id.ScopeAccess)
+}
+
+// NetworkManagerDeploymentID parses a NetworkManagerDeployment ID into an NetworkManagerDeploymentId struct
+func NetworkManagerDeploymentID(input string) (*NetworkManagerDeploymentId, error) {
+	segments := strings.Split(input, "|")
+	if len(segments) != 2 || !strings.HasSuffix(segments[0], "/commit") {
+		return nil, fmt.Errorf("expected ID to be in the format {networkManagerId}/commit|{scopeAccess} but got %q", input)
+	}
+
+	networkManagerId, err := NetworkManagerID(strings.TrimSuffix(segments[0], "/commit"))
+	if err != nil {
+		return nil, err
+	}
+
+	if segments[1] == "" {
+		return nil, fmt.Errorf("ID was missing the 'scopeAccess' element")
+	}
+
+	resourceId := NetworkManagerDeploymentId{
+		SubscriptionId:     networkManagerId.SubscriptionId,
+		ResourceGroup:      networkManagerId.ResourceGroup,
+		NetworkManagerName: networkManagerId.Name,
+		ScopeAccess:        segments[1],
+	}
+
+	return &resourceId, nil
+}
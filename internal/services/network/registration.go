@@ -37,6 +37,7 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerAdminRuleResource{},
 		ManagerAdminRuleCollectionResource{},
 		ManagerConnectivityConfigurationResource{},
+		ManagerDeploymentResource{},
 		ManagerManagementGroupConnectionResource{},
 		ManagerNetworkGroupResource{},
 		ManagerResource{},
@@ -34,6 +34,28 @@ func TestAccVirtualNetworkPeering_basic(t *testing.T) {
 	})
 }
 
+func TestAccVirtualNetworkPeering_differentSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
+	r := VirtualNetworkPeeringResource{}
+	secondResourceName := "azurerm_virtual_network_peering.test2"
+
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` is not specified")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.differentSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(secondResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttr(secondResourceName, "subscription_id", data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccVirtualNetworkPeering_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_virtual_network_peering", "test1")
 	r := VirtualNetworkPeeringResource{}
@@ -100,7 +122,11 @@ func (t VirtualNetworkPeeringResource) Exists(ctx context.Context, clients *clie
 	if err != nil {
 		return nil, err
 	}
-	resp, err := clients.Network.VnetPeeringsClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
+	subscriptionClient, err := clients.ClientForSubscription(id.SubscriptionId)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := subscriptionClient.Network.VnetPeeringsClient.Get(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
@@ -169,6 +195,64 @@ resource "azurerm_virtual_network_peering" "test2" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (VirtualNetworkPeeringResource) differentSubscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm-alt" {
+  subscription_id = "%[3]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "alt" {
+  provider = azurerm-alt
+
+  name     = "acctestRG-alt-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test1" {
+  name                = "acctestvirtnet-1-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.1.0/24"]
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_virtual_network" "test2" {
+  provider = azurerm-alt
+
+  name                = "acctestvirtnet-2-%[1]d"
+  resource_group_name = azurerm_resource_group.alt.name
+  address_space       = ["10.0.2.0/24"]
+  location            = azurerm_resource_group.alt.location
+}
+
+resource "azurerm_virtual_network_peering" "test1" {
+  name                         = "acctestpeer-1-%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  virtual_network_name         = azurerm_virtual_network.test1.name
+  remote_virtual_network_id    = azurerm_virtual_network.test2.id
+  allow_virtual_network_access = true
+}
+
+resource "azurerm_virtual_network_peering" "test2" {
+  name                         = "acctestpeer-2-%[1]d"
+  subscription_id              = "%[3]s"
+  resource_group_name          = azurerm_resource_group.alt.name
+  virtual_network_name         = azurerm_virtual_network.test2.name
+  remote_virtual_network_id    = azurerm_virtual_network.test1.id
+  allow_virtual_network_access = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionIDAlt)
+}
+
 func (r VirtualNetworkPeeringResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -1,8 +1,9 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/guestconfiguration/mgmt/2020-06-25/guestconfiguration" // nolint: staticcheck
-	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"      // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/guestconfiguration/mgmt/2020-06-25/guestconfiguration"         // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2020-07-01-preview/policyinsights" // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy"              // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2021-10-01/remediations"
 	assignments "github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-06-01/policyassignments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
@@ -12,6 +13,7 @@ type Client struct {
 	AssignmentsClient                   *assignments.PolicyAssignmentsClient
 	DefinitionsClient                   *policy.DefinitionsClient
 	ExemptionsClient                    *policy.ExemptionsClient
+	PolicyStatesClient                  *policyinsights.PolicyStatesClient
 	SetDefinitionsClient                *policy.SetDefinitionsClient
 	RemediationsClient                  *remediations.RemediationsClient
 	GuestConfigurationAssignmentsClient *guestconfiguration.AssignmentsClient
@@ -27,6 +29,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	exemptionsClient := policy.NewExemptionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&exemptionsClient.Client, o.ResourceManagerAuthorizer)
 
+	policyStatesClient := policyinsights.NewPolicyStatesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&policyStatesClient.Client, o.ResourceManagerAuthorizer)
+
 	setDefinitionsClient := policy.NewSetDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&setDefinitionsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -40,6 +45,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		AssignmentsClient:                   &assignmentsClient,
 		DefinitionsClient:                   &definitionsClient,
 		ExemptionsClient:                    &exemptionsClient,
+		PolicyStatesClient:                  &policyStatesClient,
 		SetDefinitionsClient:                &setDefinitionsClient,
 		RemediationsClient:                  &remediationsClient,
 		GuestConfigurationAssignmentsClient: &guestConfigurationAssignmentsClient,
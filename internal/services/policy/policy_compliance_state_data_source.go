@@ -0,0 +1,315 @@
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/policyinsights/mgmt/2020-07-01-preview/policyinsights" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ComplianceStateDataSource struct{}
+
+var _ sdk.DataSource = ComplianceStateDataSource{}
+
+type ComplianceStateDataSourceModel struct {
+	ScopeId                      string                            `tfschema:"scope_id"`
+	Filter                       string                            `tfschema:"filter"`
+	IncludeNonCompliantResources bool                              `tfschema:"include_non_compliant_resources"`
+	CompliantResources           int64                             `tfschema:"compliant_resources"`
+	NonCompliantResources        int64                             `tfschema:"non_compliant_resources"`
+	NonCompliantPolicies         int64                             `tfschema:"non_compliant_policies"`
+	PolicyAssignments            []PolicyAssignmentComplianceModel `tfschema:"policy_assignments"`
+}
+
+type PolicyAssignmentComplianceModel struct {
+	PolicyAssignmentId      string   `tfschema:"policy_assignment_id"`
+	PolicySetDefinitionId   string   `tfschema:"policy_set_definition_id"`
+	CompliantResources      int64    `tfschema:"compliant_resources"`
+	NonCompliantResources   int64    `tfschema:"non_compliant_resources"`
+	NonCompliantPolicies    int64    `tfschema:"non_compliant_policies"`
+	NonCompliantResourceIds []string `tfschema:"non_compliant_resource_ids"`
+}
+
+func (ComplianceStateDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+				azure.ValidateResourceID,
+			),
+		},
+
+		"filter": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+
+		"include_non_compliant_resources": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+}
+
+func (ComplianceStateDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"compliant_resources": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_resources": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_policies": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"policy_assignments": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"policy_assignment_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_set_definition_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"compliant_resources": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_resources": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_policies": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_resource_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (ComplianceStateDataSource) ModelObject() interface{} {
+	return &ComplianceStateDataSourceModel{}
+}
+
+func (ComplianceStateDataSource) ResourceType() string {
+	return "azurerm_policy_compliance_state"
+}
+
+func (ComplianceStateDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.PolicyStatesClient
+
+			var state ComplianceStateDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			scope := newPolicyStatesScope(state.ScopeId)
+
+			summary, err := scope.summarize(ctx, client, state.Filter)
+			if err != nil {
+				return fmt.Errorf("summarizing the Policy States for %s: %+v", scope, err)
+			}
+
+			nonCompliantResourceIds := make(map[string][]string)
+			if state.IncludeNonCompliantResources {
+				filter := "ComplianceState eq 'NonCompliant'"
+				if state.Filter != "" {
+					filter = fmt.Sprintf("(%s) and %s", state.Filter, filter)
+				}
+
+				if nonCompliantResourceIds, err = scope.listResourceIdsByAssignment(ctx, client, filter); err != nil {
+					return fmt.Errorf("listing the non-compliant Policy States for %s: %+v", scope, err)
+				}
+			}
+
+			state.PolicyAssignments = make([]PolicyAssignmentComplianceModel, 0)
+			if summary != nil {
+				state.CompliantResources, state.NonCompliantResources, state.NonCompliantPolicies = flattenPolicyComplianceSummaryResults(summary.Results)
+
+				if summary.PolicyAssignments != nil {
+					for _, item := range *summary.PolicyAssignments {
+						assignment := PolicyAssignmentComplianceModel{
+							NonCompliantResourceIds: make([]string, 0),
+						}
+						if item.PolicyAssignmentID != nil {
+							assignment.PolicyAssignmentId = *item.PolicyAssignmentID
+							if v, ok := nonCompliantResourceIds[strings.ToLower(*item.PolicyAssignmentID)]; ok {
+								assignment.NonCompliantResourceIds = v
+							}
+						}
+						if item.PolicySetDefinitionID != nil {
+							assignment.PolicySetDefinitionId = *item.PolicySetDefinitionID
+						}
+						assignment.CompliantResources, assignment.NonCompliantResources, assignment.NonCompliantPolicies = flattenPolicyComplianceSummaryResults(item.Results)
+
+						state.PolicyAssignments = append(state.PolicyAssignments, assignment)
+					}
+				}
+			}
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.PolicyInsights/policyStates/%s", strings.TrimSuffix(state.ScopeId, "/"), policyinsights.Latest))
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func flattenPolicyComplianceSummaryResults(input *policyinsights.SummaryResults) (compliantResources int64, nonCompliantResources int64, nonCompliantPolicies int64) {
+	if input == nil {
+		return
+	}
+
+	if input.NonCompliantResources != nil {
+		nonCompliantResources = int64(*input.NonCompliantResources)
+	}
+	if input.NonCompliantPolicies != nil {
+		nonCompliantPolicies = int64(*input.NonCompliantPolicies)
+	}
+	if input.ResourceDetails != nil {
+		for _, detail := range *input.ResourceDetails {
+			if detail.ComplianceState != nil && strings.EqualFold(*detail.ComplianceState, "compliant") && detail.Count != nil {
+				compliantResources += int64(*detail.Count)
+			}
+		}
+	}
+
+	return
+}
+
+// policyStatesScope is the scope at which the Policy States are queried, since the API has a separate operation for each kind of scope
+type policyStatesScope struct {
+	id              string
+	managementGroup *commonids.ManagementGroupId
+	subscription    *commonids.SubscriptionId
+	resourceGroup   *commonids.ResourceGroupId
+}
+
+func newPolicyStatesScope(input string) policyStatesScope {
+	scope := policyStatesScope{
+		id: input,
+	}
+
+	if id, err := commonids.ParseManagementGroupID(input); err == nil {
+		scope.managementGroup = id
+	} else if id, err := commonids.ParseSubscriptionID(input); err == nil {
+		scope.subscription = id
+	} else if id, err := commonids.ParseResourceGroupID(input); err == nil {
+		scope.resourceGroup = id
+	}
+
+	return scope
+}
+
+func (s policyStatesScope) String() string {
+	return fmt.Sprintf("the scope %q", s.id)
+}
+
+func (s policyStatesScope) summarize(ctx context.Context, client *policyinsights.PolicyStatesClient, filter string) (*policyinsights.Summary, error) {
+	var resp policyinsights.SummarizeResults
+	var err error
+
+	switch {
+	case s.managementGroup != nil:
+		resp, err = client.SummarizeForManagementGroup(ctx, s.managementGroup.GroupId, nil, nil, nil, filter)
+	case s.subscription != nil:
+		resp, err = client.SummarizeForSubscription(ctx, s.subscription.SubscriptionId, nil, nil, nil, filter)
+	case s.resourceGroup != nil:
+		resp, err = client.SummarizeForResourceGroup(ctx, s.resourceGroup.SubscriptionId, s.resourceGroup.ResourceGroupName, nil, nil, nil, filter)
+	default:
+		resp, err = client.SummarizeForResource(ctx, s.id, nil, nil, nil, filter)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Value == nil || len(*resp.Value) == 0 {
+		return nil, nil
+	}
+
+	return &(*resp.Value)[0], nil
+}
+
+// listResourceIdsByAssignment returns the IDs of the Resources matching the filter, keyed by the lower-cased Policy Assignment ID
+func (s policyStatesScope) listResourceIdsByAssignment(ctx context.Context, client *policyinsights.PolicyStatesClient, filter string) (map[string][]string, error) {
+	const selectParameter = "ResourceId, PolicyAssignmentId"
+
+	var iterator policyinsights.PolicyStatesQueryResultsIterator
+	var err error
+
+	switch {
+	case s.managementGroup != nil:
+		iterator, err = client.ListQueryResultsForManagementGroupComplete(ctx, policyinsights.Latest, s.managementGroup.GroupId, nil, "", selectParameter, nil, nil, filter, "", "")
+	case s.subscription != nil:
+		iterator, err = client.ListQueryResultsForSubscriptionComplete(ctx, policyinsights.Latest, s.subscription.SubscriptionId, nil, "", selectParameter, nil, nil, filter, "", "")
+	case s.resourceGroup != nil:
+		iterator, err = client.ListQueryResultsForResourceGroupComplete(ctx, policyinsights.Latest, s.resourceGroup.SubscriptionId, s.resourceGroup.ResourceGroupName, nil, "", selectParameter, nil, nil, filter, "", "")
+	default:
+		iterator, err = client.ListQueryResultsForResourceComplete(ctx, policyinsights.Latest, s.id, nil, "", selectParameter, nil, nil, filter, "", "", "")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// a Resource can be non-compliant with several Policy Definitions within the same Policy Assignment
+	seen := make(map[string]map[string]struct{})
+	for iterator.NotDone() {
+		state := iterator.Value()
+		if state.PolicyAssignmentID != nil && state.ResourceID != nil {
+			assignmentId := strings.ToLower(*state.PolicyAssignmentID)
+			if _, ok := seen[assignmentId]; !ok {
+				seen[assignmentId] = make(map[string]struct{})
+			}
+			seen[assignmentId][*state.ResourceID] = struct{}{}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	output := make(map[string][]string)
+	for assignmentId, resourceIds := range seen {
+		output[assignmentId] = sortedKeys(resourceIds)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,76 @@
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ComplianceStateDataSource struct{}
+
+func TestAccDataSourceComplianceState_subscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_state", "test")
+	d := ComplianceStateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.subscription(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("compliant_resources").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_resources").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_policies").Exists(),
+				check.That(data.ResourceName).Key("policy_assignments.#").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceComplianceState_nonCompliantResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_state", "test")
+	d := ComplianceStateDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.nonCompliantResources(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("non_compliant_resources").Exists(),
+				check.That(data.ResourceName).Key("policy_assignments.#").Exists(),
+			),
+		},
+	})
+}
+
+func (ComplianceStateDataSource) subscription() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+data "azurerm_policy_compliance_state" "test" {
+  scope_id = data.azurerm_subscription.current.id
+}
+`
+}
+
+func (ComplianceStateDataSource) nonCompliantResources(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%d"
+  location = "%s"
+}
+
+data "azurerm_policy_compliance_state" "test" {
+  scope_id                        = azurerm_resource_group.test.id
+  filter                          = "PolicyDefinitionAction eq 'audit'"
+  include_non_compliant_resources = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
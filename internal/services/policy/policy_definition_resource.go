@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	mgmtGrpParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/managementgroup/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -39,6 +40,8 @@ func resourceArmPolicyDefinition() *pluginsdk.Resource {
 		},
 
 		Schema: resourceArmPolicyDefinitionSchema(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(policyDefinitionCustomizeDiff),
 	}
 }
 
@@ -341,7 +344,7 @@ func resourceArmPolicyDefinitionSchema() map[string]*pluginsdk.Schema {
 		"policy_rule": {
 			Type:             pluginsdk.TypeString,
 			Optional:         true,
-			ValidateFunc:     validation.StringIsJSON,
+			ValidateFunc:     validate.PolicyRule,
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
 		},
 
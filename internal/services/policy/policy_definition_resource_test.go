@@ -3,6 +3,7 @@ package policy_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
@@ -136,6 +137,30 @@ func TestAccAzureRMPolicyDefinition_modeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicyDefinition_undeclaredParameter(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_definition", "test")
+	r := PolicyDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.undeclaredParameter(data),
+			ExpectError: regexp.MustCompile("referenced but are not declared within `parameters`"),
+		},
+	})
+}
+
+func TestAccAzureRMPolicyDefinition_unknownAlias(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_definition", "test")
+	r := PolicyDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unknownAlias(data),
+			ExpectError: regexp.MustCompile("aren't supported by their Resource Providers"),
+		},
+	})
+}
+
 func (r PolicyDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	definitionsClient := client.Policy.DefinitionsClient
 	id, err := parse.PolicyDefinitionID(state.ID)
@@ -249,6 +274,78 @@ POLICY_RULE
 `, data.RandomInteger, data.RandomInteger)
 }
 
+func (r PolicyDefinitionResource) undeclaredParameter(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "not": {
+      "field": "location",
+      "in": "[parameters('allowedLocations')]"
+    }
+  },
+  "then": {
+    "effect": "[parameters('effect')]"
+  }
+}
+POLICY_RULE
+
+  parameters = <<PARAMETERS
+{
+  "allowedLocations": {
+    "type": "Array"
+  }
+}
+PARAMETERS
+}
+`, data.RandomInteger, data.RandomInteger)
+}
+
+func (r PolicyDefinitionResource) unknownAlias(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%d"
+  policy_type  = "Custom"
+  mode         = "Indexed"
+  display_name = "acctestpol-%d"
+
+  policy_rule = <<POLICY_RULE
+{
+  "if": {
+    "allOf": [
+      {
+        "field": "type",
+        "equals": "Microsoft.Storage/storageAccounts"
+      },
+      {
+        "field": "Microsoft.Storage/storageAccounts/doesNotExist",
+        "equals": false
+      }
+    ]
+  },
+  "then": {
+    "effect": "Deny"
+  }
+}
+POLICY_RULE
+}
+`, data.RandomInteger, data.RandomInteger)
+}
+
 func (r PolicyDefinitionResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
@@ -0,0 +1,284 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// the layout used by the Azure Policy community repository (https://github.com/Azure/azure-policy), where each
+// Policy Definition is within its own directory, either as a complete definition or as separate rules and parameters
+const (
+	policyDefinitionFileName           = "azurepolicy.json"
+	policyDefinitionRulesFileName      = "azurepolicy.rules.json"
+	policyDefinitionParametersFileName = "azurepolicy.parameters.json"
+)
+
+type DefinitionsFromDirectoryDataSource struct{}
+
+var _ sdk.DataSource = DefinitionsFromDirectoryDataSource{}
+
+type DefinitionsFromDirectoryDataSourceModel struct {
+	Path              string            `tfschema:"path"`
+	PolicyDefinitions map[string]string `tfschema:"policy_definitions"`
+}
+
+// policyDefinitionFromDirectory is a Policy Definition loaded from the directory, which is exposed as a JSON object
+// within `policy_definitions` since a map can only contain primitive values
+type policyDefinitionFromDirectory struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Mode        string `json:"mode"`
+	Metadata    string `json:"metadata"`
+	Parameters  string `json:"parameters"`
+	PolicyRule  string `json:"policy_rule"`
+	FilePath    string `json:"file_path"`
+}
+
+func (DefinitionsFromDirectoryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"path": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+}
+
+func (DefinitionsFromDirectoryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"policy_definitions": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (DefinitionsFromDirectoryDataSource) ModelObject() interface{} {
+	return &DefinitionsFromDirectoryDataSourceModel{}
+}
+
+func (DefinitionsFromDirectoryDataSource) ResourceType() string {
+	return "azurerm_policy_definitions_from_directory"
+}
+
+func (DefinitionsFromDirectoryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state DefinitionsFromDirectoryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			definitions, warnings, err := loadPolicyDefinitionsFromDirectory(state.Path)
+			if err != nil {
+				return fmt.Errorf("loading the Policy Definitions from %q: %+v", state.Path, err)
+			}
+			for _, warning := range warnings {
+				metadata.Logger.Warn(warning)
+			}
+
+			state.PolicyDefinitions = make(map[string]string, len(definitions))
+			for _, definition := range definitions {
+				v, err := json.Marshal(definition)
+				if err != nil {
+					return fmt.Errorf("encoding the Policy Definition %q: %+v", definition.Name, err)
+				}
+				state.PolicyDefinitions[definition.Name] = string(v)
+			}
+
+			metadata.ResourceData.SetId(state.Path)
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// policyDefinitionFile is the format of a complete Policy Definition within `azurepolicy.json`
+type policyDefinitionFile struct {
+	Name       string `json:"name"`
+	Properties *struct {
+		DisplayName string          `json:"displayName"`
+		Description string          `json:"description"`
+		Mode        string          `json:"mode"`
+		Metadata    json.RawMessage `json:"metadata"`
+		Parameters  json.RawMessage `json:"parameters"`
+		PolicyRule  json.RawMessage `json:"policyRule"`
+	} `json:"properties"`
+}
+
+// loadPolicyDefinitionsFromDirectory walks the directory, returning the Policy Definitions sorted by name along
+// with any issues found when validating each Policy Rule
+func loadPolicyDefinitionsFromDirectory(path string) ([]policyDefinitionFromDirectory, []string, error) {
+	definitions := make([]policyDefinitionFromDirectory, 0)
+	warnings := make([]string, 0)
+	names := make(map[string]string)
+
+	err := filepath.WalkDir(path, func(dir string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() {
+			return nil
+		}
+
+		definition, definitionWarnings, err := loadPolicyDefinitionFromDirectory(dir)
+		if err != nil {
+			return err
+		}
+		warnings = append(warnings, definitionWarnings...)
+		if definition == nil {
+			return nil
+		}
+
+		if existing, ok := names[strings.ToLower(definition.Name)]; ok {
+			return fmt.Errorf("the Policy Definition %q is defined in both %q and %q", definition.Name, existing, definition.FilePath)
+		}
+		names[strings.ToLower(definition.Name)] = definition.FilePath
+
+		definitions = append(definitions, *definition)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].Name < definitions[j].Name
+	})
+
+	return definitions, warnings, nil
+}
+
+func loadPolicyDefinitionFromDirectory(dir string) (*policyDefinitionFromDirectory, []string, error) {
+	var definition policyDefinitionFromDirectory
+
+	definitionPath := filepath.Join(dir, policyDefinitionFileName)
+	rulesPath := filepath.Join(dir, policyDefinitionRulesFileName)
+
+	switch {
+	case fileExists(definitionPath):
+		var file policyDefinitionFile
+		if err := readJSONFile(definitionPath, &file); err != nil {
+			return nil, nil, err
+		}
+		if file.Properties == nil {
+			return nil, nil, fmt.Errorf("%q: missing the `properties` of the Policy Definition", definitionPath)
+		}
+
+		definition = policyDefinitionFromDirectory{
+			Name:        file.Name,
+			DisplayName: file.Properties.DisplayName,
+			Description: file.Properties.Description,
+			Mode:        file.Properties.Mode,
+			Metadata:    flattenRawJSON(file.Properties.Metadata),
+			Parameters:  flattenRawJSON(file.Properties.Parameters),
+			PolicyRule:  flattenRawJSON(file.Properties.PolicyRule),
+			FilePath:    definitionPath,
+		}
+
+	case fileExists(rulesPath):
+		var rule json.RawMessage
+		if err := readJSONFile(rulesPath, &rule); err != nil {
+			return nil, nil, err
+		}
+
+		definition = policyDefinitionFromDirectory{
+			PolicyRule: flattenRawJSON(rule),
+			FilePath:   rulesPath,
+		}
+
+		parametersPath := filepath.Join(dir, policyDefinitionParametersFileName)
+		if fileExists(parametersPath) {
+			var parameters json.RawMessage
+			if err := readJSONFile(parametersPath, &parameters); err != nil {
+				return nil, nil, err
+			}
+			definition.Parameters = flattenRawJSON(parameters)
+		}
+
+	default:
+		return nil, nil, nil
+	}
+
+	if definition.Name == "" {
+		definition.Name = filepath.Base(dir)
+	}
+	if definition.DisplayName == "" {
+		definition.DisplayName = definition.Name
+	}
+	if definition.Mode == "" {
+		definition.Mode = "All"
+	}
+
+	if definition.PolicyRule == "" {
+		return nil, nil, fmt.Errorf("%q: missing the Policy Rule", definition.FilePath)
+	}
+
+	// as with the `policy_rule` of the resource, differences from the grammar are returned as warnings since
+	// the grammar is extended by the service over time - the Policy Rule is validated by the API when it's applied
+	var rule interface{}
+	if err := json.Unmarshal([]byte(definition.PolicyRule), &rule); err != nil {
+		return nil, nil, fmt.Errorf("%q: parsing the Policy Rule: %+v", definition.FilePath, err)
+	}
+	warnings := make([]string, 0)
+	for _, err := range validate.PolicyRuleErrors(rule) {
+		warnings = append(warnings, fmt.Sprintf("%q: the Policy Rule may not be valid: %+v", definition.FilePath, err))
+	}
+
+	references, err := policyRuleReferencesFromJSON(definition.PolicyRule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%q: parsing the Policy Rule: %+v", definition.FilePath, err)
+	}
+	if err := references.validateParameters(definition.Parameters); err != nil {
+		return nil, nil, fmt.Errorf("%q: %+v", definition.FilePath, err)
+	}
+
+	return &definition, warnings, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+func readJSONFile(path string, v interface{}) error {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	if err := json.Unmarshal(contents, v); err != nil {
+		return fmt.Errorf("parsing %q: %+v", path, err)
+	}
+
+	return nil
+}
+
+// flattenRawJSON returns the compacted JSON, or an empty string when it's absent
+func flattenRawJSON(input json.RawMessage) string {
+	if len(input) == 0 || string(input) == "null" {
+		return ""
+	}
+
+	v, err := json.Marshal(input)
+	if err != nil {
+		return string(input)
+	}
+	return string(v)
+}
@@ -0,0 +1,48 @@
+package policy_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DefinitionsFromDirectoryDataSource struct{}
+
+func TestAccDataSourceDefinitionsFromDirectory_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_definitions_from_directory", "test")
+	d := DefinitionsFromDirectoryDataSource{}
+
+	// Terraform runs within a temporary directory, so the path needs to be absolute
+	path, err := filepath.Abs(filepath.Join("testdata", "policy_definitions"))
+	if err != nil {
+		t.Fatalf("determining the path to the Policy Definitions: %+v", err)
+	}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(path),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("policy_definitions.%").HasValue("3"),
+				check.That(data.ResourceName).Key("policy_definitions.allowed-locations").MatchesRegex(regexp.MustCompile(`"mode":"Indexed"`)),
+				check.That(data.ResourceName).Key("policy_definitions.deny-public-ip").Exists(),
+				check.That(data.ResourceName).Key("policy_definitions.require-https-storage").Exists(),
+			),
+		},
+	})
+}
+
+func (DefinitionsFromDirectoryDataSource) basic(path string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_policy_definitions_from_directory" "test" {
+  path = %q
+}
+`, path)
+}
@@ -0,0 +1,225 @@
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyDefinitionCustomizeDiff validates the `policy_rule` against the `parameters` and the aliases
+// supported by the Resource Providers, so that mistakes are surfaced at plan time
+func policyDefinitionCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("policy_rule") || !diff.NewValueKnown("parameters") {
+		return nil
+	}
+
+	policyRule := diff.Get("policy_rule").(string)
+	if policyRule == "" {
+		return nil
+	}
+
+	references, err := policyRuleReferencesFromJSON(policyRule)
+	if err != nil {
+		return fmt.Errorf("parsing `policy_rule`: %+v", err)
+	}
+
+	// the API rejects a Policy Rule which references parameters which aren't declared, or aliases which aren't supported
+	// by their Resource Provider - so these are surfaced at plan time rather than once the Policy Definition is applied
+	if err := references.validateParameters(diff.Get("parameters").(string)); err != nil {
+		return fmt.Errorf("validating `policy_rule`: %+v", err)
+	}
+
+	// aliases are only available for the Resource Manager modes, the Resource Provider modes use their own properties
+	if diff.NewValueKnown("mode") {
+		if mode := diff.Get("mode").(string); strings.EqualFold(mode, "All") || strings.EqualFold(mode, "Indexed") {
+			client := meta.(*clients.Client).Resource.ResourceProvidersClient
+			if err := references.validateAliases(ctx, client); err != nil {
+				return fmt.Errorf("validating `policy_rule`: %+v", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// policyRuleReferences are the fields and parameters referenced within a Policy Rule
+type policyRuleReferences struct {
+	Fields     []string
+	Parameters []string
+}
+
+func policyRuleReferencesFromJSON(input string) (*policyRuleReferences, error) {
+	var rule interface{}
+	if err := json.Unmarshal([]byte(input), &rule); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]struct{})
+	parameters := make(map[string]struct{})
+	collectPolicyRuleReferences(rule, fields, parameters)
+
+	return &policyRuleReferences{
+		Fields:     sortedKeys(fields),
+		Parameters: sortedKeys(parameters),
+	}, nil
+}
+
+var policyParameterReferenceRegex = regexp.MustCompile(`parameters\(\s*'([^']+)'\s*\)`)
+
+func collectPolicyRuleReferences(input interface{}, fields map[string]struct{}, parameters map[string]struct{}) {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			// the template deployed by a DeployIfNotExists effect has its own parameters, which are
+			// unrelated to those of the Policy Definition
+			if strings.EqualFold(key, "template") {
+				continue
+			}
+
+			if field, ok := value.(string); ok && strings.EqualFold(key, "field") && !validate.IsPolicyExpression(field) {
+				fields[field] = struct{}{}
+			}
+
+			collectPolicyRuleReferences(value, fields, parameters)
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			collectPolicyRuleReferences(item, fields, parameters)
+		}
+
+	case string:
+		if validate.IsPolicyExpression(v) {
+			for _, match := range policyParameterReferenceRegex.FindAllStringSubmatch(v, -1) {
+				parameters[match[1]] = struct{}{}
+			}
+		}
+	}
+}
+
+// validateParameters ensures that each parameter referenced within the Policy Rule is declared
+func (r policyRuleReferences) validateParameters(input string) error {
+	declared := make(map[string]interface{})
+	if input != "" {
+		if err := json.Unmarshal([]byte(input), &declared); err != nil {
+			return fmt.Errorf("parsing `parameters`: %+v", err)
+		}
+	}
+
+	missing := make([]string, 0)
+	for _, parameter := range r.Parameters {
+		found := false
+		for name := range declared {
+			if strings.EqualFold(name, parameter) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, parameter)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("the parameters %q are referenced but are not declared within `parameters`", strings.Join(missing, `", "`))
+	}
+
+	return nil
+}
+
+// validateAliases ensures that each alias referenced within the Policy Rule is supported by its Resource Provider
+func (r policyRuleReferences) validateAliases(ctx context.Context, client *resources.ProvidersClient) error {
+	unknown := make([]string, 0)
+	for _, field := range r.Fields {
+		if validate.IsPolicyWellKnownField(field) {
+			continue
+		}
+
+		namespace := strings.Split(field, "/")[0]
+		aliases, err := policyAliases.forNamespace(ctx, client, namespace)
+		if err != nil {
+			return err
+		}
+
+		// the catalogue couldn't be retrieved (e.g. due to permissions) - so the API will validate it instead
+		if aliases == nil {
+			continue
+		}
+
+		if _, ok := aliases[strings.ToLower(field)]; !ok {
+			unknown = append(unknown, field)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return fmt.Errorf("the aliases %q aren't supported by their Resource Providers", strings.Join(unknown, `", "`))
+	}
+
+	return nil
+}
+
+// policyAliasCatalogue caches the aliases supported by each Resource Provider, since retrieving them is
+// expensive and they don't change during a run
+type policyAliasCatalogue struct {
+	lock       sync.Mutex
+	namespaces map[string]map[string]struct{}
+}
+
+var policyAliases = &policyAliasCatalogue{
+	namespaces: make(map[string]map[string]struct{}),
+}
+
+func (c *policyAliasCatalogue) forNamespace(ctx context.Context, client *resources.ProvidersClient, namespace string) (map[string]struct{}, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := strings.ToLower(namespace)
+	if aliases, ok := c.namespaces[key]; ok {
+		return aliases, nil
+	}
+
+	resp, err := client.Get(ctx, namespace, "resourceTypes/aliases")
+	if err != nil {
+		// the Resource Provider may not be registered or the API may be unreachable, so the aliases are
+		// validated by the API when the Policy Rule is applied
+		log.Printf("[DEBUG] Unable to retrieve the aliases for the Resource Provider %q, skipping validation: %+v", namespace, err)
+		c.namespaces[key] = nil
+		return nil, nil
+	}
+
+	aliases := make(map[string]struct{})
+	if resp.ResourceTypes != nil {
+		for _, resourceType := range *resp.ResourceTypes {
+			if resourceType.Aliases == nil {
+				continue
+			}
+			for _, alias := range *resourceType.Aliases {
+				if alias.Name != nil {
+					aliases[strings.ToLower(*alias.Name)] = struct{}{}
+				}
+			}
+		}
+	}
+
+	c.namespaces[key] = aliases
+	return aliases, nil
+}
+
+func sortedKeys(input map[string]struct{}) []string {
+	output := make([]string, 0, len(input))
+	for k := range input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,135 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPolicyRuleReferencesValidateParameters(t *testing.T) {
+	testData := []struct {
+		Name       string
+		PolicyRule string
+		Parameters string
+		Expected   bool
+	}{
+		{
+			Name:       "no references",
+			PolicyRule: `{"if": {"field": "location", "equals": "westeurope"}, "then": {"effect": "Deny"}}`,
+			Expected:   true,
+		},
+		{
+			Name:       "declared",
+			PolicyRule: `{"if": {"field": "location", "in": "[parameters('allowedLocations')]"}, "then": {"effect": "[parameters('effect')]"}}`,
+			Parameters: `{"allowedLocations": {"type": "Array"}, "Effect": {"type": "String"}}`,
+			Expected:   true,
+		},
+		{
+			Name:       "undeclared",
+			PolicyRule: `{"if": {"field": "location", "in": "[parameters('allowedLocations')]"}, "then": {"effect": "Deny"}}`,
+			Expected:   false,
+		},
+		{
+			Name:       "nested within a function",
+			PolicyRule: `{"if": {"value": "[concat(parameters('prefix'), '-')]", "equals": "a-"}, "then": {"effect": "Deny"}}`,
+			Parameters: `{"suffix": {"type": "String"}}`,
+			Expected:   false,
+		},
+		{
+			Name:       "escaped expression",
+			PolicyRule: `{"if": {"field": "name", "equals": "[[parameters('name')]"}, "then": {"effect": "Deny"}}`,
+			Expected:   true,
+		},
+		{
+			Name:       "deployment template parameters",
+			PolicyRule: `{"if": {"field": "type", "equals": "Microsoft.Sql/servers"}, "then": {"effect": "DeployIfNotExists", "details": {"deployment": {"properties": {"template": {"resources": [{"name": "[parameters('serverName')]"}]}}}}}}`,
+			Expected:   true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		references, err := policyRuleReferencesFromJSON(v.PolicyRule)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Name, err)
+		}
+
+		err = references.validateParameters(v.Parameters)
+		actual := err == nil
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %+v", v.Expected, actual, v.Name, err)
+		}
+	}
+}
+
+func TestLoadPolicyDefinitionsFromDirectory(t *testing.T) {
+	definitions, warnings, err := loadPolicyDefinitionsFromDirectory(filepath.Join("testdata", "policy_definitions"))
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	if len(warnings) > 0 {
+		t.Fatalf("expected no warnings but got: %+v", warnings)
+	}
+
+	expected := []struct {
+		Name       string
+		Mode       string
+		Parameters bool
+	}{
+		{Name: "allowed-locations", Mode: "Indexed", Parameters: true},
+		{Name: "deny-public-ip", Mode: "All", Parameters: false},
+		{Name: "require-https-storage", Mode: "All", Parameters: true},
+	}
+
+	if len(definitions) != len(expected) {
+		t.Fatalf("expected %d Policy Definitions but got %d", len(expected), len(definitions))
+	}
+
+	for i, v := range expected {
+		actual := definitions[i]
+		if actual.Name != v.Name {
+			t.Fatalf("expected the Policy Definition at %d to be %q but got %q", i, v.Name, actual.Name)
+		}
+		if actual.Mode != v.Mode {
+			t.Fatalf("expected the mode of %q to be %q but got %q", v.Name, v.Mode, actual.Mode)
+		}
+		if (actual.Parameters != "") != v.Parameters {
+			t.Fatalf("expected the parameters of %q to be set %t but got %q", v.Name, v.Parameters, actual.Parameters)
+		}
+		if actual.PolicyRule == "" {
+			t.Fatalf("expected the Policy Rule of %q to be set", v.Name)
+		}
+	}
+}
+
+func TestLoadPolicyDefinitionsFromDirectoryInvalid(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "invalid"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	rule := `{"if": {"field": "location", "in": "[parameters('allowedLocations')]"}, "then": {"effect": "Deny"}}`
+	if err := os.WriteFile(filepath.Join(dir, "invalid", policyDefinitionRulesFileName), []byte(rule), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := loadPolicyDefinitionsFromDirectory(dir); err == nil {
+		t.Fatalf("expected an error for an undeclared parameter but got none")
+	}
+}
+
+func TestLoadPolicyDefinitionsFromDirectoryInvalidJSON(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "invalid"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "invalid", policyDefinitionRulesFileName), []byte(`{"if": `), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := loadPolicyDefinitionsFromDirectory(dir); err == nil {
+		t.Fatalf("expected an error for an invalid JSON Policy Rule but got none")
+	}
+}
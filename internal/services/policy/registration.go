@@ -19,6 +19,8 @@ type Registration struct{}
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AssignmentDataSource{},
+		ComplianceStateDataSource{},
+		DefinitionsFromDirectoryDataSource{},
 	}
 }
 
@@ -0,0 +1,307 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// PolicyRule validates that the value is a JSON Policy Rule. Differences from the grammar documented at
+// https://learn.microsoft.com/azure/governance/policy/concepts/definition-structure#policy-rule are returned as
+// warnings, since the grammar is extended by the service over time and existing rules may use newer conditions
+func PolicyRule(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if v == "" {
+		return
+	}
+
+	var rule interface{}
+	if err := json.Unmarshal([]byte(v), &rule); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %+v", k, err))
+		return
+	}
+
+	for _, err := range PolicyRuleErrors(rule) {
+		warnings = append(warnings, fmt.Sprintf("%q may not be a valid Policy Rule: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// PolicyRuleErrors returns the differences between the decoded Policy Rule and the Policy Rule grammar
+func PolicyRuleErrors(input interface{}) []error {
+	rule, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("expected a JSON object")}
+	}
+
+	errors := make([]error, 0)
+	var condition, then interface{}
+	for key, value := range rule {
+		switch {
+		case strings.EqualFold(key, "if"):
+			condition = value
+		case strings.EqualFold(key, "then"):
+			then = value
+		default:
+			errors = append(errors, fmt.Errorf("unexpected key %q, only `if` and `then` are supported", key))
+		}
+	}
+
+	if condition == nil {
+		errors = append(errors, fmt.Errorf("missing the `if` condition"))
+	} else {
+		errors = append(errors, policyConditionErrors(condition, "if")...)
+	}
+
+	if then == nil {
+		errors = append(errors, fmt.Errorf("missing the `then` block"))
+	} else {
+		errors = append(errors, policyThenErrors(then)...)
+	}
+
+	return errors
+}
+
+var policyEffects = []string{
+	"Append",
+	"Audit",
+	"AuditIfNotExists",
+	"Deny",
+	"DenyAction",
+	"DeployIfNotExists",
+	"Disabled",
+	"EnforceOPAConstraint",
+	"EnforceRegoPolicy",
+	"Manual",
+	"Modify",
+	"Mutate",
+}
+
+func policyThenErrors(input interface{}) []error {
+	then, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("`then`: expected a JSON object")}
+	}
+
+	var effect interface{}
+	for key, value := range then {
+		if strings.EqualFold(key, "effect") {
+			effect = value
+		}
+	}
+
+	if effect == nil {
+		return []error{fmt.Errorf("`then`: missing the `effect`")}
+	}
+
+	v, ok := effect.(string)
+	if !ok {
+		return []error{fmt.Errorf("`then.effect`: expected a string")}
+	}
+
+	// the effect is commonly supplied using a parameter, which is validated by the API
+	if IsPolicyExpression(v) {
+		return nil
+	}
+
+	for _, known := range policyEffects {
+		if strings.EqualFold(v, known) {
+			return nil
+		}
+	}
+
+	return []error{fmt.Errorf("`then.effect`: %q is not a known effect, expected a parameter expression or one of %s", v, strings.Join(policyEffects, ", "))}
+}
+
+var policyConditionOperators = []string{
+	"contains",
+	"containsKey",
+	"equals",
+	"exists",
+	"greater",
+	"greaterOrEquals",
+	"in",
+	"less",
+	"lessOrEquals",
+	"like",
+	"match",
+	"matchInsensitively",
+	"notContains",
+	"notContainsKey",
+	"notEquals",
+	"notIn",
+	"notLike",
+	"notMatch",
+	"notMatchInsensitively",
+}
+
+func policyConditionErrors(input interface{}, path string) []error {
+	condition, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("`%s`: expected a JSON object", path)}
+	}
+
+	if len(condition) == 0 {
+		return []error{fmt.Errorf("`%s`: expected a condition or a logical operator", path)}
+	}
+
+	// logical operators are the only key within their object
+	if len(condition) == 1 {
+		for key, value := range condition {
+			switch {
+			case strings.EqualFold(key, "allOf"), strings.EqualFold(key, "anyOf"):
+				conditions, ok := value.([]interface{})
+				if !ok {
+					return []error{fmt.Errorf("`%s.%s`: expected an array of conditions", path, key)}
+				}
+				errors := make([]error, 0)
+				for i, item := range conditions {
+					errors = append(errors, policyConditionErrors(item, fmt.Sprintf("%s.%s[%d]", path, key, i))...)
+				}
+				return errors
+
+			case strings.EqualFold(key, "not"):
+				return policyConditionErrors(value, fmt.Sprintf("%s.%s", path, key))
+			}
+		}
+	}
+
+	errors := make([]error, 0)
+	sources := make([]string, 0)
+	operators := make([]string, 0)
+	for key, value := range condition {
+		switch {
+		case strings.EqualFold(key, "field"):
+			sources = append(sources, key)
+			errors = append(errors, policyFieldErrors(value, fmt.Sprintf("%s.%s", path, key))...)
+
+		case strings.EqualFold(key, "value"):
+			sources = append(sources, key)
+
+		case strings.EqualFold(key, "count"):
+			sources = append(sources, key)
+			errors = append(errors, policyCountErrors(value, fmt.Sprintf("%s.%s", path, key))...)
+
+		case isPolicyConditionOperator(key):
+			operators = append(operators, key)
+
+		case strings.EqualFold(key, "allOf"), strings.EqualFold(key, "anyOf"), strings.EqualFold(key, "not"):
+			errors = append(errors, fmt.Errorf("`%s`: the logical operator %q must be the only key within its object", path, key))
+
+		default:
+			errors = append(errors, fmt.Errorf("`%s`: unexpected key %q, expected one of `field`, `value` or `count` and a condition operator", path, key))
+		}
+	}
+
+	if len(sources) != 1 {
+		errors = append(errors, fmt.Errorf("`%s`: expected exactly one of `field`, `value` or `count` but got %d", path, len(sources)))
+	}
+
+	if len(operators) != 1 {
+		errors = append(errors, fmt.Errorf("`%s`: expected exactly one condition operator but got %d", path, len(operators)))
+	}
+
+	return errors
+}
+
+func isPolicyConditionOperator(input string) bool {
+	for _, operator := range policyConditionOperators {
+		if strings.EqualFold(input, operator) {
+			return true
+		}
+	}
+	return false
+}
+
+func policyCountErrors(input interface{}, path string) []error {
+	count, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("`%s`: expected a JSON object", path)}
+	}
+
+	errors := make([]error, 0)
+	sources := 0
+	for key, value := range count {
+		switch {
+		case strings.EqualFold(key, "field"):
+			sources++
+			errors = append(errors, policyFieldErrors(value, fmt.Sprintf("%s.%s", path, key))...)
+			if v, ok := value.(string); ok && !IsPolicyExpression(v) && !strings.Contains(v, "[*]") {
+				errors = append(errors, fmt.Errorf("`%s.%s`: expected an array alias containing `[*]` but got %q", path, key, v))
+			}
+
+		case strings.EqualFold(key, "value"):
+			sources++
+
+		case strings.EqualFold(key, "name"):
+			if _, ok := value.(string); !ok {
+				errors = append(errors, fmt.Errorf("`%s.%s`: expected a string", path, key))
+			}
+
+		case strings.EqualFold(key, "where"):
+			errors = append(errors, policyConditionErrors(value, fmt.Sprintf("%s.%s", path, key))...)
+
+		default:
+			errors = append(errors, fmt.Errorf("`%s`: unexpected key %q, expected `field` or `value` and optionally `name` and `where`", path, key))
+		}
+	}
+
+	if sources != 1 {
+		errors = append(errors, fmt.Errorf("`%s`: expected exactly one of `field` or `value` but got %d", path, sources))
+	}
+
+	return errors
+}
+
+var (
+	policyTagFieldRegex   = regexp.MustCompile(`(?i)^tags(\[.+\]|\..+)$`)
+	policyAliasFieldRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z0-9]+)+/.+$`)
+)
+
+var policyWellKnownFields = []string{
+	"fullName",
+	"id",
+	"identity.type",
+	"identity.userAssignedIdentities",
+	"kind",
+	"location",
+	"name",
+	"tags",
+	"type",
+}
+
+// IsPolicyWellKnownField returns whether the field is one of the properties which are available for every
+// resource, rather than a Resource Provider specific alias
+func IsPolicyWellKnownField(input string) bool {
+	for _, field := range policyWellKnownFields {
+		if strings.EqualFold(input, field) {
+			return true
+		}
+	}
+	return policyTagFieldRegex.MatchString(input)
+}
+
+// IsPolicyExpression returns whether the value is a template expression, which is evaluated by the API
+func IsPolicyExpression(input string) bool {
+	return strings.HasPrefix(input, "[") && !strings.HasPrefix(input, "[[") && strings.HasSuffix(input, "]")
+}
+
+func policyFieldErrors(input interface{}, path string) []error {
+	v, ok := input.(string)
+	if !ok {
+		return []error{fmt.Errorf("`%s`: expected a string", path)}
+	}
+
+	if IsPolicyExpression(v) || IsPolicyWellKnownField(v) || policyAliasFieldRegex.MatchString(v) {
+		return nil
+	}
+
+	return []error{fmt.Errorf("`%s`: %q is not a template expression, a property available for every resource (such as `name`, `type` or `tags`) or an alias in the format `Namespace.Provider/resourceType/property`", path, v)}
+}
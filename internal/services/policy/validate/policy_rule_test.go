@@ -0,0 +1,137 @@
+package validate
+
+import "testing"
+
+func TestValidatePolicyRule(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "empty",
+			Input:    "",
+			Expected: true,
+		},
+		{
+			Name:     "invalid json",
+			Input:    `{"if": `,
+			Expected: false,
+		},
+		{
+			Name:     "not an object",
+			Input:    `["if", "then"]`,
+			Expected: false,
+		},
+		{
+			Name:     "basic example",
+			Input:    `{"if": {"field": "location", "notIn": ["westeurope"]}, "then": {"effect": "audit"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "missing then",
+			Input:    `{"if": {"field": "location", "equals": "westeurope"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "missing if",
+			Input:    `{"then": {"effect": "deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "unexpected top-level key",
+			Input:    `{"if": {"field": "location", "equals": "westeurope"}, "then": {"effect": "deny"}, "else": {}}`,
+			Expected: false,
+		},
+		{
+			Name:     "unknown effect",
+			Input:    `{"if": {"field": "location", "equals": "westeurope"}, "then": {"effect": "Block"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "parameterised effect",
+			Input:    `{"if": {"field": "location", "equals": "westeurope"}, "then": {"effect": "[parameters('effect')]"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "logical operators",
+			Input:    `{"if": {"allOf": [{"field": "type", "equals": "Microsoft.Storage/storageAccounts"}, {"not": {"anyOf": [{"field": "tags['environment']", "exists": true}, {"field": "tags.owner", "exists": true}]}}]}, "then": {"effect": "Deny"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "logical operator alongside a condition",
+			Input:    `{"if": {"field": "location", "equals": "westeurope", "allOf": []}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "logical operator without an array",
+			Input:    `{"if": {"anyOf": {"field": "location", "equals": "westeurope"}}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "condition without an operator",
+			Input:    `{"if": {"field": "location"}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "condition with two operators",
+			Input:    `{"if": {"field": "location", "equals": "westeurope", "notEquals": "northeurope"}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "condition with two sources",
+			Input:    `{"if": {"field": "location", "value": "westeurope", "equals": "westeurope"}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "unknown operator",
+			Input:    `{"if": {"field": "location", "startsWith": "west"}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "alias field",
+			Input:    `{"if": {"field": "Microsoft.Storage/storageAccounts/supportsHttpsTrafficOnly", "equals": false}, "then": {"effect": "Deny"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "invalid field",
+			Input:    `{"if": {"field": "properties.supportsHttpsTrafficOnly", "equals": false}, "then": {"effect": "Deny"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "value condition with an expression",
+			Input:    `{"if": {"value": "[resourceGroup().name]", "like": "prod-*"}, "then": {"effect": "Deny"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "count",
+			Input:    `{"if": {"count": {"field": "Microsoft.Network/networkSecurityGroups/securityRules[*]", "where": {"field": "Microsoft.Network/networkSecurityGroups/securityRules[*].direction", "equals": "Inbound"}}, "greater": 0}, "then": {"effect": "Audit"}}`,
+			Expected: true,
+		},
+		{
+			Name:     "count of a non-array field",
+			Input:    `{"if": {"count": {"field": "Microsoft.Network/networkSecurityGroups/securityRules"}, "greater": 0}, "then": {"effect": "Audit"}}`,
+			Expected: false,
+		},
+		{
+			Name:     "value count",
+			Input:    `{"if": {"count": {"value": "[parameters('names')]", "name": "pattern", "where": {"field": "name", "like": "[current('pattern')]"}}, "equals": 0}, "then": {"effect": "Deny"}}`,
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		warnings, errors := PolicyRule(v.Input, "policy_rule")
+		actual := len(warnings) == 0 && len(errors) == 0
+		if v.Expected != actual {
+			t.Fatalf("Expected %t but got %t for %q: %+v %+v", v.Expected, actual, v.Name, warnings, errors)
+		}
+
+		// only invalid JSON is an error, differences from the grammar are warnings
+		if v.Name != "invalid json" && len(errors) > 0 {
+			t.Fatalf("Expected no errors for %q but got: %+v", v.Name, errors)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
@@ -161,12 +162,7 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ValidateFunc: privatezones.ValidatePrivateDnsZoneID,
 			},
 
-			"point_in_time_restore_time_in_utc": {
-				Type:         pluginsdk.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: validation.IsRFC3339Time,
-			},
+			"point_in_time_restore_time_in_utc": restore.PointInTimeSchema(),
 
 			"source_server_id": {
 				Type:         pluginsdk.TypeString,
@@ -321,6 +317,7 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 			}
 			return nil
 		},
+			restore.ValidatePointInTimeInDiff("source_server_id", "point_in_time_restore_time_in_utc", postgresqlFlexibleServerRestoreWindow),
 		),
 	}
 }
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-12-01/servers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/restore"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourcePostgresqlFlexibleServerRestorableTimestamps() *pluginsdk.Resource {
+	s := map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: servers.ValidateFlexibleServerID,
+		},
+	}
+	for k, v := range restore.SchemaDataSource() {
+		s[k] = v
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourcePostgresqlFlexibleServerRestorableTimestampsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourcePostgresqlFlexibleServerRestorableTimestampsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := servers.ParseFlexibleServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+
+	window, err := postgresqlFlexibleServerRestoreWindow(ctx, meta, id.ID())
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+	d.Set("server_id", id.ID())
+
+	return window.Flatten(d)
+}
+
+// postgresqlFlexibleServerRestoreWindow returns the range of time which the PostgreSQL Flexible Server can be restored to
+func postgresqlFlexibleServerRestoreWindow(ctx context.Context, meta interface{}, sourceId string) (*restore.Window, error) {
+	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+
+	id, err := servers.ParseFlexibleServerID(sourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("%s was not found", *id)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Backup == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.backup` was nil", *id)
+	}
+
+	earliest, err := resp.Model.Properties.Backup.GetEarliestRestoreDateAsTime()
+	if err != nil {
+		return nil, fmt.Errorf("parsing the earliest restore date for %s: %+v", *id, err)
+	}
+	if earliest == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties.backup.earliestRestoreDate` was nil", *id)
+	}
+
+	return &restore.Window{
+		Earliest: *earliest,
+		Latest:   time.Now().UTC(),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package postgres_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PostgresqlFlexibleServerRestorableTimestampsDataSource struct{}
+
+func TestAccDataSourcePostgresqlFlexibleServerRestorableTimestamps_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_postgresql_flexible_server_restorable_timestamps", "test")
+	r := PostgresqlFlexibleServerRestorableTimestampsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("earliest_restore_time").Exists(),
+				check.That(data.ResourceName).Key("latest_restore_time").Exists(),
+			),
+		},
+	})
+}
+
+func (PostgresqlFlexibleServerRestorableTimestampsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_postgresql_flexible_server_restorable_timestamps" "test" {
+  server_id = azurerm_postgresql_flexible_server.test.id
+}
+`, PostgresqlFlexibleServerResource{}.basic(data))
+}
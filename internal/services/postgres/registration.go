@@ -28,8 +28,9 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_postgresql_server":          dataSourcePostgreSqlServer(),
-		"azurerm_postgresql_flexible_server": dataSourcePostgresqlFlexibleServer(),
+		"azurerm_postgresql_server":                                dataSourcePostgreSqlServer(),
+		"azurerm_postgresql_flexible_server":                       dataSourcePostgresqlFlexibleServer(),
+		"azurerm_postgresql_flexible_server_restorable_timestamps": dataSourcePostgresqlFlexibleServerRestorableTimestamps(),
 	}
 }
 
@@ -58,6 +58,15 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 			// TODO: make this case sensitive once the API's fixed https://github.com/Azure/azure-rest-api-specs/issues/10933
 			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
 
+			// allows the Link to be managed for a Private DNS Zone in a Subscription other than the one the Provider is configured for
+			"subscription_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
 			"virtual_network_id": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
@@ -79,6 +88,9 @@ func resourcePrivateDnsZoneVirtualNetworkLink() *pluginsdk.Resource {
 func resourcePrivateDnsZoneVirtualNetworkLinkCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.VirtualNetworkLinksClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	if v, ok := d.GetOk("subscription_id"); ok {
+		subscriptionId = v.(string)
+	}
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -142,6 +154,7 @@ func resourcePrivateDnsZoneVirtualNetworkLinkRead(d *pluginsdk.ResourceData, met
 	d.Set("name", id.VirtualNetworkLinkName)
 	d.Set("private_dns_zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
+	d.Set("subscription_id", id.SubscriptionId)
 
 	if model := resp.Model; model != nil {
 		if props := model.Properties; props != nil {
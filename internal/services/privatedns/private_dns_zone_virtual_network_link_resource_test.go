@@ -29,6 +29,26 @@ func TestAccPrivateDnsZoneVirtualNetworkLink_basic(t *testing.T) {
 	})
 }
 
+func TestAccPrivateDnsZoneVirtualNetworkLink_differentSubscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_link", "test")
+	r := PrivateDnsZoneVirtualNetworkLinkResource{}
+
+	if data.Client().SubscriptionIDAlt == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` is not specified")
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.differentSubscription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("subscription_id").HasValue(data.Client().SubscriptionIDAlt),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccPrivateDnsZoneVirtualNetworkLink_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_private_dns_zone_virtual_network_link", "test")
 	r := PrivateDnsZoneVirtualNetworkLinkResource{}
@@ -130,6 +150,53 @@ resource "azurerm_private_dns_zone_virtual_network_link" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger)
 }
 
+func (PrivateDnsZoneVirtualNetworkLinkResource) differentSubscription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azurerm-alt" {
+  subscription_id = "%[3]s"
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group" "alt" {
+  provider = azurerm-alt
+
+  name     = "acctestRG-alt-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "vnet%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_private_dns_zone" "alt" {
+  provider = azurerm-alt
+
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.alt.name
+}
+
+resource "azurerm_private_dns_zone_virtual_network_link" "test" {
+  name                  = "acctestVnetZone%[1]d.com"
+  subscription_id       = "%[3]s"
+  private_dns_zone_name = azurerm_private_dns_zone.alt.name
+  virtual_network_id    = azurerm_virtual_network.test.id
+  resource_group_name   = azurerm_resource_group.alt.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.Client().SubscriptionIDAlt)
+}
+
 func (PrivateDnsZoneVirtualNetworkLinkResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -2,25 +2,33 @@ package client
 
 import (
 	alertruletemplates "github.com/Azure/azure-sdk-for-go/services/preview/securityinsight/mgmt/2021-09-01-preview/securityinsight" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/automationrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/sourcecontrols"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/sentinelonboardingstates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/contentpackages"
 	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
 
 type Client struct {
-	AlertRulesClient         *alertrules.AlertRulesClient
-	AlertRuleTemplatesClient *alertruletemplates.AlertRuleTemplatesClient
-	AutomationRulesClient    *automationrules.AutomationRulesClient
-	DataConnectorsClient     *securityinsight.DataConnectorsClient
-	WatchlistsClient         *securityinsight.WatchlistsClient
-	WatchlistItemsClient     *securityinsight.WatchlistItemsClient
-	OnboardingStatesClient   *sentinelonboardingstates.SentinelOnboardingStatesClient
-	AnalyticsSettingsClient  *securityinsight.SecurityMLAnalyticsSettingsClient
+	AlertRulesClient             *alertrules.AlertRulesClient
+	AlertRuleTemplatesClient     *alertruletemplates.AlertRuleTemplatesClient
+	AutomationRulesClient        *automationrules.AutomationRulesClient
+	ContentPackagesClient        *contentpackages.ContentPackagesClient
+	ContentProductPackagesClient *contentpackages.ContentProductPackagesClient
+	DataConnectorsClient         *securityinsight.DataConnectorsClient
+	WatchlistsClient             *securityinsight.WatchlistsClient
+	WatchlistItemsClient         *securityinsight.WatchlistItemsClient
+	OnboardingStatesClient       *sentinelonboardingstates.SentinelOnboardingStatesClient
+	AnalyticsSettingsClient      *securityinsight.SecurityMLAnalyticsSettingsClient
+	SavedSearchesClient          *savedsearches.SavedSearchesClient
+	ThreatIntelligenceClient     *securityinsight.ThreatIntelligenceIndicatorClient
+	SourceControlsClient         *sourcecontrols.SourceControlsClient
 }
 
-func NewClient(o *common.ClientOptions) *Client {
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	alertRulesClient := alertrules.NewAlertRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&alertRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -30,6 +38,18 @@ func NewClient(o *common.ClientOptions) *Client {
 	automationRulesClient := automationrules.NewAutomationRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&automationRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	contentPackagesClient, err := contentpackages.NewContentPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(contentPackagesClient.Client, o.Authorizers.ResourceManager)
+
+	contentProductPackagesClient, err := contentpackages.NewContentProductPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, err
+	}
+	o.Configure(contentProductPackagesClient.Client, o.Authorizers.ResourceManager)
+
 	dataConnectorsClient := securityinsight.NewDataConnectorsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&dataConnectorsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -45,14 +65,28 @@ func NewClient(o *common.ClientOptions) *Client {
 	analyticsSettingsClient := securityinsight.NewSecurityMLAnalyticsSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&analyticsSettingsClient.Client, o.ResourceManagerAuthorizer)
 
+	savedSearchesClient := savedsearches.NewSavedSearchesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&savedSearchesClient.Client, o.ResourceManagerAuthorizer)
+
+	threatIntelligenceClient := securityinsight.NewThreatIntelligenceIndicatorClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&threatIntelligenceClient.Client, o.ResourceManagerAuthorizer)
+
+	sourceControlsClient := sourcecontrols.NewSourceControlsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sourceControlsClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		AlertRulesClient:         &alertRulesClient,
-		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
-		AutomationRulesClient:    &automationRulesClient,
-		DataConnectorsClient:     &dataConnectorsClient,
-		WatchlistsClient:         &watchListsClient,
-		WatchlistItemsClient:     &watchListItemsClient,
-		OnboardingStatesClient:   &onboardingStatesClient,
-		AnalyticsSettingsClient:  &analyticsSettingsClient,
-	}
+		AlertRulesClient:             &alertRulesClient,
+		AlertRuleTemplatesClient:     &alertRuleTemplatesClient,
+		AutomationRulesClient:        &automationRulesClient,
+		ContentPackagesClient:        contentPackagesClient,
+		ContentProductPackagesClient: contentProductPackagesClient,
+		DataConnectorsClient:         &dataConnectorsClient,
+		WatchlistsClient:             &watchListsClient,
+		WatchlistItemsClient:         &watchListItemsClient,
+		OnboardingStatesClient:       &onboardingStatesClient,
+		AnalyticsSettingsClient:      &analyticsSettingsClient,
+		SavedSearchesClient:          &savedSearchesClient,
+		ThreatIntelligenceClient:     &threatIntelligenceClient,
+		SourceControlsClient:         &sourceControlsClient,
+	}, nil
 }
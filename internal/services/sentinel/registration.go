@@ -57,6 +57,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AlertRuleAnomalyDataSource{},
+		AlertRuleScheduledYamlDataSource{},
 	}
 }
 
@@ -76,5 +77,9 @@ func (r Registration) Resources() []sdk.Resource {
 		DataConnectorThreatIntelligenceTAXIIResource{},
 		DataConnectorMicrosoftThreatIntelligenceResource{},
 		AlertRuleAnomalyBuiltInResource{},
+		ThreatIntelligenceIndicatorResource{},
+		HuntingQueryResource{},
+		ContentPackageResource{},
+		SourceControlResource{},
 	}
 }
@@ -0,0 +1,38 @@
+package contentpackages
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+type ContentPackagesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewContentPackagesClientWithBaseURI(api environments.Api) (*ContentPackagesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "contentpackages", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ContentPackagesClient: %+v", err)
+	}
+
+	return &ContentPackagesClient{
+		Client: client,
+	}, nil
+}
+
+type ContentProductPackagesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewContentProductPackagesClientWithBaseURI(api environments.Api) (*ContentProductPackagesClient, error) {
+	client, err := resourcemanager.NewResourceManagerClient(api, "contentpackages", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating ContentProductPackagesClient: %+v", err)
+	}
+
+	return &ContentProductPackagesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,15 @@
+package contentpackages
+
+type Flag string
+
+const (
+	FlagFalse Flag = "false"
+	FlagTrue  Flag = "true"
+)
+
+type PackageKind string
+
+const (
+	PackageKindSolution   PackageKind = "Solution"
+	PackageKindStandalone PackageKind = "Standalone"
+)
@@ -0,0 +1,58 @@
+package contentpackages
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata"
+)
+
+type PackageModel struct {
+	Etag       *string                `json:"etag,omitempty"`
+	Id         *string                `json:"id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	Properties *PackageProperties     `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData `json:"systemData,omitempty"`
+	Type       *string                `json:"type,omitempty"`
+}
+
+type PackageProperties struct {
+	ContentId            *string      `json:"contentId,omitempty"`
+	ContentKind          *PackageKind `json:"contentKind,omitempty"`
+	ContentProductId     *string      `json:"contentProductId,omitempty"`
+	ContentSchemaVersion *string      `json:"contentSchemaVersion,omitempty"`
+	Description          *string      `json:"description,omitempty"`
+	DisplayName          *string      `json:"displayName,omitempty"`
+	FirstPublishDate     *string      `json:"firstPublishDate,omitempty"`
+	IsFeatured           *Flag        `json:"isFeatured,omitempty"`
+	IsNew                *Flag        `json:"isNew,omitempty"`
+	IsPreview            *Flag        `json:"isPreview,omitempty"`
+	LastPublishDate      *string      `json:"lastPublishDate,omitempty"`
+	Providers            *[]string    `json:"providers,omitempty"`
+	PublisherDisplayName *string      `json:"publisherDisplayName,omitempty"`
+	Version              *string      `json:"version,omitempty"`
+}
+
+type ProductPackageModel struct {
+	Etag       *string                   `json:"etag,omitempty"`
+	Id         *string                   `json:"id,omitempty"`
+	Name       *string                   `json:"name,omitempty"`
+	Properties *ProductPackageProperties `json:"properties,omitempty"`
+	SystemData *systemdata.SystemData    `json:"systemData,omitempty"`
+	Type       *string                   `json:"type,omitempty"`
+}
+
+type ProductPackageProperties struct {
+	ContentId            *string      `json:"contentId,omitempty"`
+	ContentKind          *PackageKind `json:"contentKind,omitempty"`
+	ContentProductId     *string      `json:"contentProductId,omitempty"`
+	ContentSchemaVersion *string      `json:"contentSchemaVersion,omitempty"`
+	Description          *string      `json:"description,omitempty"`
+	DisplayName          *string      `json:"displayName,omitempty"`
+	FirstPublishDate     *string      `json:"firstPublishDate,omitempty"`
+	InstalledVersion     *string      `json:"installedVersion,omitempty"`
+	IsFeatured           *Flag        `json:"isFeatured,omitempty"`
+	IsNew                *Flag        `json:"isNew,omitempty"`
+	IsPreview            *Flag        `json:"isPreview,omitempty"`
+	LastPublishDate      *string      `json:"lastPublishDate,omitempty"`
+	Providers            *[]string    `json:"providers,omitempty"`
+	PublisherDisplayName *string      `json:"publisherDisplayName,omitempty"`
+	Version              *string      `json:"version,omitempty"`
+}
@@ -0,0 +1,3 @@
+package contentpackages
+
+const defaultApiVersion = "2023-06-01-preview"
@@ -0,0 +1,226 @@
+package sentinel
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"gopkg.in/yaml.v3"
+)
+
+// sentinelAnalyticsRuleYaml is the format used for the Analytics Rules within the Azure Sentinel GitHub repository,
+// see https://github.com/Azure/Azure-Sentinel/wiki/Query-Style-Guide
+type sentinelAnalyticsRuleYaml struct {
+	Id                    string                                   `yaml:"id"`
+	Name                  string                                   `yaml:"name"`
+	Description           string                                   `yaml:"description"`
+	Kind                  string                                   `yaml:"kind"`
+	Severity              string                                   `yaml:"severity"`
+	Query                 string                                   `yaml:"query"`
+	QueryFrequency        string                                   `yaml:"queryFrequency"`
+	QueryPeriod           string                                   `yaml:"queryPeriod"`
+	TriggerOperator       string                                   `yaml:"triggerOperator"`
+	TriggerThreshold      *int                                     `yaml:"triggerThreshold"`
+	Tactics               []string                                 `yaml:"tactics"`
+	RelevantTechniques    []string                                 `yaml:"relevantTechniques"`
+	EntityMappings        []sentinelAnalyticsRuleEntityMappingYaml `yaml:"entityMappings"`
+	EventGroupingSettings *struct {
+		AggregationKind string `yaml:"aggregationKind"`
+	} `yaml:"eventGroupingSettings"`
+	CustomDetails        map[string]string `yaml:"customDetails"`
+	AlertDetailsOverride *struct {
+		AlertDisplayNameFormat  string `yaml:"alertDisplayNameFormat"`
+		AlertDescriptionFormat  string `yaml:"alertDescriptionFormat"`
+		AlertTacticsColumnName  string `yaml:"alertTacticsColumnName"`
+		AlertSeverityColumnName string `yaml:"alertSeverityColumnName"`
+	} `yaml:"alertDetailsOverride"`
+	Version string `yaml:"version"`
+}
+
+type sentinelAnalyticsRuleEntityMappingYaml struct {
+	EntityType    string `yaml:"entityType"`
+	FieldMappings []struct {
+		Identifier string `yaml:"identifier"`
+		ColumnName string `yaml:"columnName"`
+	} `yaml:"fieldMappings"`
+}
+
+// parseSentinelAnalyticsRuleYaml parses the Analytics Rule YAML into the arguments used by `azurerm_sentinel_alert_rule_scheduled`
+func parseSentinelAnalyticsRuleYaml(input string) (*AlertRuleScheduledYamlDataSourceModel, error) {
+	var rule sentinelAnalyticsRuleYaml
+	if err := yaml.Unmarshal([]byte(input), &rule); err != nil {
+		return nil, fmt.Errorf("parsing the Analytics Rule YAML: %+v", err)
+	}
+
+	if rule.Kind != "" && !strings.EqualFold(rule.Kind, string(alertrules.AlertRuleKindScheduled)) {
+		return nil, fmt.Errorf("only Analytics Rules of the kind %q are supported but got %q", alertrules.AlertRuleKindScheduled, rule.Kind)
+	}
+	if rule.Name == "" {
+		return nil, fmt.Errorf("`name` must be specified in the Analytics Rule YAML")
+	}
+	if strings.TrimSpace(rule.Query) == "" {
+		return nil, fmt.Errorf("`query` must be specified in the Analytics Rule YAML")
+	}
+
+	output := AlertRuleScheduledYamlDataSourceModel{
+		DisplayName:              rule.Name,
+		Description:              strings.TrimSpace(rule.Description),
+		Query:                    strings.TrimSpace(rule.Query),
+		AlertRuleTemplateGuid:    rule.Id,
+		AlertRuleTemplateVersion: rule.Version,
+		TriggerThreshold:         0,
+		Tactics:                  make([]string, 0),
+		Techniques:               make([]string, 0),
+		EntityMappings:           make([]AlertRuleScheduledYamlEntityMappingModel, 0),
+		EventGrouping:            make([]AlertRuleScheduledYamlEventGroupingModel, 0),
+		CustomDetails:            make(map[string]string),
+		AlertDetailsOverride:     make([]AlertRuleScheduledYamlAlertDetailsOverrideModel, 0),
+	}
+
+	severity, err := normalizeSentinelAnalyticsRuleValue(rule.Severity, alertrules.PossibleValuesForAlertSeverity())
+	if err != nil {
+		return nil, fmt.Errorf("parsing `severity`: %+v", err)
+	}
+	output.Severity = severity
+
+	// the defaults match those of `azurerm_sentinel_alert_rule_scheduled`
+	output.QueryFrequency = "PT5H"
+	if rule.QueryFrequency != "" {
+		if output.QueryFrequency, err = convertSentinelAnalyticsRuleDuration(rule.QueryFrequency); err != nil {
+			return nil, fmt.Errorf("parsing `queryFrequency`: %+v", err)
+		}
+	}
+
+	output.QueryPeriod = "PT5H"
+	if rule.QueryPeriod != "" {
+		if output.QueryPeriod, err = convertSentinelAnalyticsRuleDuration(rule.QueryPeriod); err != nil {
+			return nil, fmt.Errorf("parsing `queryPeriod`: %+v", err)
+		}
+	}
+
+	output.TriggerOperator = string(alertrules.TriggerOperatorGreaterThan)
+	if rule.TriggerOperator != "" {
+		if output.TriggerOperator, err = convertSentinelAnalyticsRuleTriggerOperator(rule.TriggerOperator); err != nil {
+			return nil, fmt.Errorf("parsing `triggerOperator`: %+v", err)
+		}
+	}
+
+	if rule.TriggerThreshold != nil {
+		output.TriggerThreshold = int64(*rule.TriggerThreshold)
+	}
+
+	for _, v := range rule.Tactics {
+		tactic, err := normalizeSentinelAnalyticsRuleValue(v, alertrules.PossibleValuesForAttackTactic())
+		if err != nil {
+			return nil, fmt.Errorf("parsing `tactics`: %+v", err)
+		}
+		output.Tactics = append(output.Tactics, tactic)
+	}
+
+	output.Techniques = append(output.Techniques, rule.RelevantTechniques...)
+
+	for _, mapping := range rule.EntityMappings {
+		entityType, err := normalizeSentinelAnalyticsRuleValue(mapping.EntityType, alertrules.PossibleValuesForEntityMappingType())
+		if err != nil {
+			return nil, fmt.Errorf("parsing `entityMappings`: %+v", err)
+		}
+
+		entityMapping := AlertRuleScheduledYamlEntityMappingModel{
+			EntityType:    entityType,
+			FieldMappings: make([]AlertRuleScheduledYamlFieldMappingModel, 0),
+		}
+		for _, field := range mapping.FieldMappings {
+			entityMapping.FieldMappings = append(entityMapping.FieldMappings, AlertRuleScheduledYamlFieldMappingModel{
+				Identifier: field.Identifier,
+				ColumnName: field.ColumnName,
+			})
+		}
+		output.EntityMappings = append(output.EntityMappings, entityMapping)
+	}
+
+	if v := rule.EventGroupingSettings; v != nil && v.AggregationKind != "" {
+		aggregationMethod, err := normalizeSentinelAnalyticsRuleValue(v.AggregationKind, alertrules.PossibleValuesForEventGroupingAggregationKind())
+		if err != nil {
+			return nil, fmt.Errorf("parsing `eventGroupingSettings`: %+v", err)
+		}
+		output.EventGrouping = append(output.EventGrouping, AlertRuleScheduledYamlEventGroupingModel{
+			AggregationMethod: aggregationMethod,
+		})
+	}
+
+	for k, v := range rule.CustomDetails {
+		output.CustomDetails[k] = v
+	}
+
+	if v := rule.AlertDetailsOverride; v != nil {
+		output.AlertDetailsOverride = append(output.AlertDetailsOverride, AlertRuleScheduledYamlAlertDetailsOverrideModel{
+			DisplayNameFormat:  v.AlertDisplayNameFormat,
+			DescriptionFormat:  v.AlertDescriptionFormat,
+			TacticsColumnName:  v.AlertTacticsColumnName,
+			SeverityColumnName: v.AlertSeverityColumnName,
+		})
+	}
+
+	return &output, nil
+}
+
+var sentinelAnalyticsRuleDurationRegex = regexp.MustCompile(`^(\d+)([mhd])$`)
+
+// convertSentinelAnalyticsRuleDuration converts the short-form durations (e.g. `5m`, `1h` and `14d`) used
+// within the Analytics Rule YAML into an ISO8601 duration - existing ISO8601 durations are returned as-is
+func convertSentinelAnalyticsRuleDuration(input string) (string, error) {
+	input = strings.TrimSpace(input)
+	if strings.HasPrefix(strings.ToUpper(input), "P") {
+		return strings.ToUpper(input), nil
+	}
+
+	matches := sentinelAnalyticsRuleDurationRegex.FindStringSubmatch(strings.ToLower(input))
+	if len(matches) != 3 {
+		return "", fmt.Errorf("expected a duration such as `5m`, `1h` or `1d` but got %q", input)
+	}
+
+	value, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	switch matches[2] {
+	case "m":
+		return fmt.Sprintf("PT%dM", value), nil
+	case "h":
+		return fmt.Sprintf("PT%dH", value), nil
+	default:
+		return fmt.Sprintf("P%dD", value), nil
+	}
+}
+
+func convertSentinelAnalyticsRuleTriggerOperator(input string) (string, error) {
+	operators := map[string]alertrules.TriggerOperator{
+		"gt": alertrules.TriggerOperatorGreaterThan,
+		"lt": alertrules.TriggerOperatorLessThan,
+		"eq": alertrules.TriggerOperatorEqual,
+		"ne": alertrules.TriggerOperatorNotEqual,
+	}
+	if v, ok := operators[strings.ToLower(input)]; ok {
+		return string(v), nil
+	}
+
+	return normalizeSentinelAnalyticsRuleValue(input, alertrules.PossibleValuesForTriggerOperator())
+}
+
+// normalizeSentinelAnalyticsRuleValue returns the casing of the value used by the API, since the YAML isn't consistent
+func normalizeSentinelAnalyticsRuleValue(input string, possibleValues []string) (string, error) {
+	for _, v := range possibleValues {
+		if strings.EqualFold(v, input) {
+			return v, nil
+		}
+	}
+
+	values := make([]string, len(possibleValues))
+	copy(values, possibleValues)
+	sort.Strings(values)
+	return "", fmt.Errorf("expected one of %q but got %q", strings.Join(values, ", "), input)
+}
@@ -0,0 +1,247 @@
+package sentinel
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AlertRuleScheduledYamlDataSourceModel struct {
+	Content                  string                                            `tfschema:"content"`
+	DisplayName              string                                            `tfschema:"display_name"`
+	Description              string                                            `tfschema:"description"`
+	Severity                 string                                            `tfschema:"severity"`
+	Query                    string                                            `tfschema:"query"`
+	QueryFrequency           string                                            `tfschema:"query_frequency"`
+	QueryPeriod              string                                            `tfschema:"query_period"`
+	TriggerOperator          string                                            `tfschema:"trigger_operator"`
+	TriggerThreshold         int64                                             `tfschema:"trigger_threshold"`
+	Tactics                  []string                                          `tfschema:"tactics"`
+	Techniques               []string                                          `tfschema:"techniques"`
+	EntityMappings           []AlertRuleScheduledYamlEntityMappingModel        `tfschema:"entity_mapping"`
+	EventGrouping            []AlertRuleScheduledYamlEventGroupingModel        `tfschema:"event_grouping"`
+	CustomDetails            map[string]string                                 `tfschema:"custom_details"`
+	AlertDetailsOverride     []AlertRuleScheduledYamlAlertDetailsOverrideModel `tfschema:"alert_details_override"`
+	AlertRuleTemplateGuid    string                                            `tfschema:"alert_rule_template_guid"`
+	AlertRuleTemplateVersion string                                            `tfschema:"alert_rule_template_version"`
+}
+
+type AlertRuleScheduledYamlEntityMappingModel struct {
+	EntityType    string                                    `tfschema:"entity_type"`
+	FieldMappings []AlertRuleScheduledYamlFieldMappingModel `tfschema:"field_mapping"`
+}
+
+type AlertRuleScheduledYamlFieldMappingModel struct {
+	Identifier string `tfschema:"identifier"`
+	ColumnName string `tfschema:"column_name"`
+}
+
+type AlertRuleScheduledYamlEventGroupingModel struct {
+	AggregationMethod string `tfschema:"aggregation_method"`
+}
+
+type AlertRuleScheduledYamlAlertDetailsOverrideModel struct {
+	DisplayNameFormat  string `tfschema:"display_name_format"`
+	DescriptionFormat  string `tfschema:"description_format"`
+	TacticsColumnName  string `tfschema:"tactics_column_name"`
+	SeverityColumnName string `tfschema:"severity_column_name"`
+}
+
+type AlertRuleScheduledYamlDataSource struct{}
+
+var _ sdk.DataSource = AlertRuleScheduledYamlDataSource{}
+
+func (d AlertRuleScheduledYamlDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (d AlertRuleScheduledYamlDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"description": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"severity": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"query": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"query_frequency": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"query_period": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"trigger_operator": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"trigger_threshold": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"tactics": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"techniques": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"entity_mapping": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"entity_type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"field_mapping": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"identifier": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"column_name": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"event_grouping": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"aggregation_method": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"custom_details": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"alert_details_override": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"display_name_format": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"description_format": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"tactics_column_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"severity_column_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"alert_rule_template_guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"alert_rule_template_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (d AlertRuleScheduledYamlDataSource) ModelObject() interface{} {
+	return &AlertRuleScheduledYamlDataSourceModel{}
+}
+
+func (d AlertRuleScheduledYamlDataSource) ResourceType() string {
+	return "azurerm_sentinel_alert_rule_scheduled_yaml"
+}
+
+func (d AlertRuleScheduledYamlDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var input AlertRuleScheduledYamlDataSourceModel
+			if err := metadata.Decode(&input); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state, err := parseSentinelAnalyticsRuleYaml(input.Content)
+			if err != nil {
+				return err
+			}
+			state.Content = input.Content
+
+			// the Analytics Rule is parsed locally, so the ID is derived from the content
+			metadata.ResourceData.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(input.Content))))
+			return metadata.Encode(state)
+		},
+	}
+}
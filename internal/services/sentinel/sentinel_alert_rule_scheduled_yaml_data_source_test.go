@@ -0,0 +1,65 @@
+package sentinel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type SentinelAlertRuleScheduledYamlDataSource struct{}
+
+func TestAccSentinelAlertRuleScheduledYamlDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_sentinel_alert_rule_scheduled_yaml", "test")
+	r := SentinelAlertRuleScheduledYamlDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Suspicious sign-in"),
+				check.That(data.ResourceName).Key("severity").HasValue("Medium"),
+				check.That(data.ResourceName).Key("query_frequency").HasValue("PT1H"),
+				check.That(data.ResourceName).Key("query_period").HasValue("P1D"),
+				check.That(data.ResourceName).Key("trigger_operator").HasValue("GreaterThan"),
+				check.That(data.ResourceName).Key("trigger_threshold").HasValue("0"),
+				check.That(data.ResourceName).Key("tactics.#").HasValue("1"),
+				check.That(data.ResourceName).Key("entity_mapping.#").HasValue("1"),
+				check.That(data.ResourceName).Key("alert_rule_template_guid").HasValue("6d7214d9-4a28-44df-aafb-0910b9e6ae3e"),
+			),
+		},
+	})
+}
+
+func (SentinelAlertRuleScheduledYamlDataSource) basic() string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_sentinel_alert_rule_scheduled_yaml" "test" {
+  content = %q
+}
+`, `id: 6d7214d9-4a28-44df-aafb-0910b9e6ae3e
+name: Suspicious sign-in
+description: Detects a suspicious sign-in.
+severity: Medium
+queryFrequency: 1h
+queryPeriod: 1d
+triggerOperator: gt
+triggerThreshold: 0
+tactics:
+  - InitialAccess
+query: |
+  SigninLogs
+  | where ResultType == 0
+entityMappings:
+  - entityType: Account
+    fieldMappings:
+      - identifier: FullName
+        columnName: UserPrincipalName
+version: 1.0.0
+kind: Scheduled
+`)
+}
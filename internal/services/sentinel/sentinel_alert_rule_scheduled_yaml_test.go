@@ -0,0 +1,133 @@
+package sentinel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSentinelAnalyticsRuleYaml(t *testing.T) {
+	input := `
+id: 6d7214d9-4a28-44df-aafb-0910b9e6ae3e
+name: Suspicious sign-in
+description: |
+  Detects a suspicious sign-in.
+severity: medium
+requiredDataConnectors:
+  - connectorId: AzureActiveDirectory
+    dataTypes:
+      - SigninLogs
+queryFrequency: 1h
+queryPeriod: 14d
+triggerOperator: gt
+triggerThreshold: 2
+tactics:
+  - InitialAccess
+  - credentialAccess
+relevantTechniques:
+  - T1078
+query: |
+  SigninLogs
+  | where ResultType == 0
+entityMappings:
+  - entityType: Account
+    fieldMappings:
+      - identifier: FullName
+        columnName: UserPrincipalName
+  - entityType: IP
+    fieldMappings:
+      - identifier: Address
+        columnName: IPAddress
+eventGroupingSettings:
+  aggregationKind: AlertPerResult
+customDetails:
+  Location: Location
+version: 1.0.2
+kind: Scheduled
+`
+
+	actual, err := parseSentinelAnalyticsRuleYaml(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := AlertRuleScheduledYamlDataSourceModel{
+		DisplayName:      "Suspicious sign-in",
+		Description:      "Detects a suspicious sign-in.",
+		Severity:         "Medium",
+		Query:            "SigninLogs\n| where ResultType == 0",
+		QueryFrequency:   "PT1H",
+		QueryPeriod:      "P14D",
+		TriggerOperator:  "GreaterThan",
+		TriggerThreshold: 2,
+		Tactics:          []string{"InitialAccess", "CredentialAccess"},
+		Techniques:       []string{"T1078"},
+		EntityMappings: []AlertRuleScheduledYamlEntityMappingModel{
+			{
+				EntityType: "Account",
+				FieldMappings: []AlertRuleScheduledYamlFieldMappingModel{
+					{Identifier: "FullName", ColumnName: "UserPrincipalName"},
+				},
+			},
+			{
+				EntityType: "IP",
+				FieldMappings: []AlertRuleScheduledYamlFieldMappingModel{
+					{Identifier: "Address", ColumnName: "IPAddress"},
+				},
+			},
+		},
+		EventGrouping: []AlertRuleScheduledYamlEventGroupingModel{
+			{AggregationMethod: "AlertPerResult"},
+		},
+		CustomDetails: map[string]string{
+			"Location": "Location",
+		},
+		AlertDetailsOverride:     []AlertRuleScheduledYamlAlertDetailsOverrideModel{},
+		AlertRuleTemplateGuid:    "6d7214d9-4a28-44df-aafb-0910b9e6ae3e",
+		AlertRuleTemplateVersion: "1.0.2",
+	}
+
+	if !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+}
+
+func TestParseSentinelAnalyticsRuleYamlInvalid(t *testing.T) {
+	cases := map[string]string{
+		"invalid yaml":     "name: [",
+		"missing name":     "severity: High\nquery: SigninLogs\n",
+		"missing query":    "name: rule\nseverity: High\n",
+		"unsupported kind": "name: rule\nseverity: High\nquery: SigninLogs\nkind: NRT\n",
+		"invalid severity": "name: rule\nseverity: Critical\nquery: SigninLogs\n",
+		"invalid tactic":   "name: rule\nseverity: High\nquery: SigninLogs\ntactics:\n  - Nope\n",
+		"invalid duration": "name: rule\nseverity: High\nquery: SigninLogs\nqueryFrequency: 5w\n",
+		"invalid operator": "name: rule\nseverity: High\nquery: SigninLogs\ntriggerOperator: gte\n",
+	}
+
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseSentinelAnalyticsRuleYaml(input); err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+		})
+	}
+}
+
+func TestConvertSentinelAnalyticsRuleDuration(t *testing.T) {
+	cases := map[string]string{
+		"5m":    "PT5M",
+		"12h":   "PT12H",
+		"1d":    "P1D",
+		"PT10M": "PT10M",
+		"p1d":   "P1D",
+	}
+
+	for input, expected := range cases {
+		actual, err := convertSentinelAnalyticsRuleDuration(input)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %+v", input, err)
+		}
+		if actual != expected {
+			t.Fatalf("expected %q for %q but got %q", expected, input, actual)
+		}
+	}
+}
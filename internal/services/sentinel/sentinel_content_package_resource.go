@@ -0,0 +1,284 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/sdk/2023-06-01-preview/contentpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentPackageModel struct {
+	WorkspaceId          string `tfschema:"log_analytics_workspace_id"`
+	ContentId            string `tfschema:"content_id"`
+	Version              string `tfschema:"version"`
+	ContentKind          string `tfschema:"content_kind"`
+	ContentProductId     string `tfschema:"content_product_id"`
+	DisplayName          string `tfschema:"display_name"`
+	PublisherDisplayName string `tfschema:"publisher_display_name"`
+}
+
+type ContentPackageResource struct{}
+
+var _ sdk.ResourceWithUpdate = ContentPackageResource{}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^\d+(\.\d+){0,3}$`),
+				"`version` must be a version number such as `3.0.0`",
+			),
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"content_product_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"publisher_display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ContentPackageID
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			// packages installed from the Content Hub are named after the Content ID of the package
+			id := parse.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.ContentId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input, err := resolveContentPackageFromCatalog(ctx, metadata.Client.Sentinel.ContentProductPackagesClient, id, model.Version)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Install(ctx, id, *input); err != nil {
+				return fmt.Errorf("installing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := parse.ContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := ContentPackageModel{
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				ContentId:   id.Name,
+			}
+
+			if respModel := resp.Model; respModel != nil {
+				if props := respModel.Properties; props != nil {
+					if props.ContentId != nil {
+						model.ContentId = *props.ContentId
+					}
+					model.Version = pointer.From(props.Version)
+					model.ContentKind = string(pointer.From(props.ContentKind))
+					model.ContentProductId = pointer.From(props.ContentProductId)
+					model.DisplayName = pointer.From(props.DisplayName)
+					model.PublisherDisplayName = pointer.From(props.PublisherDisplayName)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ContentPackageResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := parse.ContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// changing the version re-installs the package, providing that version is the one offered by the Content Hub
+			input, err := resolveContentPackageFromCatalog(ctx, metadata.Client.Sentinel.ContentProductPackagesClient, *id, model.Version)
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Install(ctx, *id, *input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := parse.ContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Uninstall(ctx, *id); err != nil {
+				return fmt.Errorf("uninstalling %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// resolveContentPackageFromCatalog builds the package to install from the matching product package within the
+// Content Hub catalog - since the catalog only offers the latest version of each package, a specific version can
+// only be installed when it's the version currently available in the catalog
+func resolveContentPackageFromCatalog(ctx context.Context, client *contentpackages.ContentProductPackagesClient, id parse.ContentPackageId, version string) (*contentpackages.PackageModel, error) {
+	productPackageId := parse.NewContentProductPackageID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.Name)
+	resp, err := client.Get(ctx, productPackageId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, fmt.Errorf("a package with the Content ID %q was not found in the Content Hub for Workspace %q (Resource Group %q)", id.Name, id.WorkspaceName, id.ResourceGroup)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", productPackageId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `properties` was nil", productPackageId)
+	}
+	props := resp.Model.Properties
+
+	availableVersion := pointer.From(props.Version)
+	if availableVersion == "" {
+		return nil, fmt.Errorf("retrieving %s: `version` was empty", productPackageId)
+	}
+	if version != "" && version != availableVersion {
+		return nil, fmt.Errorf("version %q of the package with the Content ID %q isn't available in the Content Hub, the version currently available is %q", version, id.Name, availableVersion)
+	}
+
+	contentKind := contentpackages.PackageKindSolution
+	if props.ContentKind != nil {
+		contentKind = *props.ContentKind
+	}
+
+	return &contentpackages.PackageModel{
+		Properties: &contentpackages.PackageProperties{
+			ContentId:            props.ContentId,
+			ContentKind:          pointer.To(contentKind),
+			ContentProductId:     props.ContentProductId,
+			ContentSchemaVersion: props.ContentSchemaVersion,
+			Description:          props.Description,
+			DisplayName:          props.DisplayName,
+			FirstPublishDate:     props.FirstPublishDate,
+			IsFeatured:           props.IsFeatured,
+			IsNew:                props.IsNew,
+			IsPreview:            props.IsPreview,
+			LastPublishDate:      props.LastPublishDate,
+			Providers:            props.Providers,
+			PublisherDisplayName: props.PublisherDisplayName,
+			Version:              pointer.To(availableVersion),
+		},
+	}, nil
+}
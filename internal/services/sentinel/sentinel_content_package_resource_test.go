@@ -0,0 +1,144 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ContentPackageResource struct{}
+
+func TestAccContentPackage_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_package", "test")
+	r := ContentPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("version").IsNotEmpty(),
+				check.That(data.ResourceName).Key("content_product_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("display_name").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContentPackage_versionNotAvailable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_package", "test")
+	r := ContentPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.version(data, "0.0.1"),
+			ExpectError: regexp.MustCompile(`version "0.0.1" of the package with the Content ID "azuresentinel.azure-sentinel-solution-office365" isn't available in the Content Hub`),
+		},
+	})
+}
+
+func TestAccContentPackage_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_content_package", "test")
+	r := ContentPackageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ContentPackageResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ContentPackageID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Sentinel.ContentPackagesClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ContentPackageResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_package" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  content_id                 = "azuresentinel.azure-sentinel-solution-office365"
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data))
+}
+
+func (r ContentPackageResource) version(data acceptance.TestData, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_package" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  content_id                 = "azuresentinel.azure-sentinel-solution-office365"
+  version                    = %q
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), version)
+}
+
+func (r ContentPackageResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_content_package" "import" {
+  log_analytics_workspace_id = azurerm_sentinel_content_package.test.log_analytics_workspace_id
+  content_id                 = azurerm_sentinel_content_package.test.content_id
+  version                    = azurerm_sentinel_content_package.test.version
+}
+`, r.basic(data))
+}
+
+func (r ContentPackageResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,368 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// Hunting Queries are stored as Saved Searches within the Log Analytics Workspace using this Category,
+// with the description, tactics and techniques stored as tags on the Saved Search
+const (
+	huntingQueryCategory       = "Hunting Queries"
+	huntingQueryTagDescription = "description"
+	huntingQueryTagTactics     = "tactics"
+	huntingQueryTagTechniques  = "techniques"
+)
+
+type HuntingQueryModel struct {
+	Name        string            `tfschema:"name"`
+	WorkspaceId string            `tfschema:"log_analytics_workspace_id"`
+	DisplayName string            `tfschema:"display_name"`
+	Query       string            `tfschema:"query"`
+	Description string            `tfschema:"description"`
+	Tactics     []string          `tfschema:"tactics"`
+	Techniques  []string          `tfschema:"techniques"`
+	Tags        map[string]string `tfschema:"tags"`
+}
+
+type HuntingQueryResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = HuntingQueryResource{}
+	_ sdk.ResourceWithCustomImporter = HuntingQueryResource{}
+)
+
+func (r HuntingQueryResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: savedsearches.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"query": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"tactics": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(alertrules.PossibleValuesForAttackTactic(), false),
+			},
+		},
+
+		"techniques": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			ValidateFunc: validateHuntingQueryTags,
+		},
+	}
+}
+
+func (r HuntingQueryResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r HuntingQueryResource) ResourceType() string {
+	return "azurerm_sentinel_hunting_query"
+}
+
+func (r HuntingQueryResource) ModelObject() interface{} {
+	return &HuntingQueryModel{}
+}
+
+func (r HuntingQueryResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return savedsearches.ValidateSavedSearchID
+}
+
+func (r HuntingQueryResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Sentinel.SavedSearchesClient
+
+		id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, *id)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", *id, err)
+		}
+
+		if resp.Model == nil || !strings.EqualFold(resp.Model.Properties.Category, huntingQueryCategory) {
+			return fmt.Errorf("%s is not a Sentinel Hunting Query since it's not in the %q category", *id, huntingQueryCategory)
+		}
+
+		return nil
+	}
+}
+
+func (r HuntingQueryResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SavedSearchesClient
+
+			var model HuntingQueryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := savedsearches.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := savedsearches.NewSavedSearchID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, expandHuntingQuery(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := HuntingQueryModel{
+				Name:        id.SavedSearchId,
+				WorkspaceId: savedsearches.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				Tactics:     make([]string, 0),
+				Techniques:  make([]string, 0),
+				Tags:        make(map[string]string),
+			}
+
+			if resp.Model != nil {
+				props := resp.Model.Properties
+				model.DisplayName = props.DisplayName
+				model.Query = props.Query
+
+				if props.Tags != nil {
+					for _, tag := range *props.Tags {
+						switch tag.Name {
+						case huntingQueryTagDescription:
+							model.Description = tag.Value
+						case huntingQueryTagTactics:
+							model.Tactics = splitHuntingQueryTagValue(tag.Value)
+						case huntingQueryTagTechniques:
+							model.Techniques = splitHuntingQueryTagValue(tag.Value)
+						default:
+							model.Tags[tag.Name] = tag.Value
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r HuntingQueryResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model HuntingQueryModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			input := expandHuntingQuery(model)
+			if existing.Model != nil {
+				// the Etag is required to update an existing Saved Search
+				input.Etag = existing.Model.Etag
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, input); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r HuntingQueryResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SavedSearchesClient
+
+			id, err := savedsearches.ParseSavedSearchID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandHuntingQuery(input HuntingQueryModel) savedsearches.SavedSearch {
+	tags := make([]savedsearches.Tag, 0)
+	if input.Description != "" {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagDescription,
+			Value: input.Description,
+		})
+	}
+	if len(input.Tactics) > 0 {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTactics,
+			Value: joinHuntingQueryTagValue(input.Tactics),
+		})
+	}
+	if len(input.Techniques) > 0 {
+		tags = append(tags, savedsearches.Tag{
+			Name:  huntingQueryTagTechniques,
+			Value: joinHuntingQueryTagValue(input.Techniques),
+		})
+	}
+
+	keys := make([]string, 0)
+	for k := range input.Tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		tags = append(tags, savedsearches.Tag{
+			Name:  k,
+			Value: input.Tags[k],
+		})
+	}
+
+	return savedsearches.SavedSearch{
+		Properties: savedsearches.SavedSearchProperties{
+			Category:    huntingQueryCategory,
+			DisplayName: input.DisplayName,
+			Query:       input.Query,
+			Tags:        &tags,
+		},
+	}
+}
+
+func joinHuntingQueryTagValue(input []string) string {
+	values := make([]string, len(input))
+	copy(values, input)
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
+func splitHuntingQueryTagValue(input string) []string {
+	output := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			output = append(output, v)
+		}
+	}
+	return output
+}
+
+func validateHuntingQueryTags(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(map[string]interface{})
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be a map", k))
+		return
+	}
+
+	for key := range v {
+		switch key {
+		case huntingQueryTagDescription, huntingQueryTagTactics, huntingQueryTagTechniques:
+			errors = append(errors, fmt.Errorf("%q cannot contain the reserved key %q, use the `%s` property instead", k, key, key))
+		}
+	}
+
+	return
+}
@@ -0,0 +1,170 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/savedsearches"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type HuntingQueryResource struct{}
+
+func TestAccHuntingQuery_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHuntingQuery_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tactics.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccHuntingQuery_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_hunting_query", "test")
+	r := HuntingQueryResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r HuntingQueryResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.SavedSearchesClient
+
+	id, err := savedsearches.ParseSavedSearchID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r HuntingQueryResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "test" {
+  name                       = "acctest-hq-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest hunting query"
+  query                      = "SigninLogs | where ResultType != 0 | summarize count() by UserPrincipalName"
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r HuntingQueryResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "test" {
+  name                       = "acctest-hq-%d"
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest hunting query updated"
+  description                = "Failed sign-ins by user"
+  query                      = "SigninLogs | where ResultType != 0 | summarize count() by UserPrincipalName, IPAddress"
+  tactics                    = ["InitialAccess", "CredentialAccess"]
+  techniques                 = ["T1078", "T1110"]
+
+  tags = {
+    team = "soc"
+  }
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r HuntingQueryResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_hunting_query" "import" {
+  name                       = azurerm_sentinel_hunting_query.test.name
+  log_analytics_workspace_id = azurerm_sentinel_hunting_query.test.log_analytics_workspace_id
+  display_name               = azurerm_sentinel_hunting_query.test.display_name
+  query                      = azurerm_sentinel_hunting_query.test.query
+}
+`, r.basic(data))
+}
+
+func (r HuntingQueryResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
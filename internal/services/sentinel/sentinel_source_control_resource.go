@@ -0,0 +1,399 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/sourcecontrols"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SourceControlModel struct {
+	WorkspaceId                    string                         `tfschema:"log_analytics_workspace_id"`
+	DisplayName                    string                         `tfschema:"display_name"`
+	Description                    string                         `tfschema:"description"`
+	RepositoryType                 string                         `tfschema:"repository_type"`
+	ContentTypes                   []string                       `tfschema:"content_types"`
+	Repository                     []SourceControlRepositoryModel `tfschema:"repository"`
+	GitHubAppInstallationId        string                         `tfschema:"github_app_installation_id"`
+	AzureDevOpsServiceConnectionId string                         `tfschema:"azure_devops_service_connection_id"`
+}
+
+type SourceControlRepositoryModel struct {
+	Url         string                          `tfschema:"url"`
+	Branch      string                          `tfschema:"branch"`
+	PathMapping []SourceControlPathMappingModel `tfschema:"path_mapping"`
+	DisplayUrl  string                          `tfschema:"display_url"`
+}
+
+type SourceControlPathMappingModel struct {
+	ContentType string `tfschema:"content_type"`
+	Path        string `tfschema:"path"`
+}
+
+type SourceControlResource struct{}
+
+var _ sdk.ResourceWithCustomizeDiff = SourceControlResource{}
+
+func (r SourceControlResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: sourcecontrols.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"repository_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForRepoType(), false),
+		},
+
+		"content_types": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForContentType(), false),
+			},
+		},
+
+		"repository": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPS,
+					},
+
+					"branch": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"path_mapping": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"content_type": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringInSlice(sourcecontrols.PossibleValuesForContentType(), false),
+								},
+
+								"path": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+
+					"display_url": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		// the repository is accessed using a GitHub App installation or an Azure DevOps Service Connection, rather
+		// than credentials, so that no secrets need to be specified or kept in the state
+		"github_app_installation_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"azure_devops_service_connection_id"},
+		},
+
+		"azure_devops_service_connection_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringIsNotEmpty,
+			ConflictsWith: []string{"github_app_installation_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SourceControlResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SourceControlResource) ResourceType() string {
+	return "azurerm_sentinel_source_control"
+}
+
+func (r SourceControlResource) ModelObject() interface{} {
+	return &SourceControlModel{}
+}
+
+func (r SourceControlResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sourcecontrols.ValidateSourceControlID
+}
+
+func (r SourceControlResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SourceControlModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.GitHubAppInstallationId != "" && model.RepositoryType != string(sourcecontrols.RepoTypeGithub) {
+				return fmt.Errorf("`github_app_installation_id` can only be specified when `repository_type` is %q", string(sourcecontrols.RepoTypeGithub))
+			}
+			if model.AzureDevOpsServiceConnectionId != "" && model.RepositoryType != string(sourcecontrols.RepoTypeDevOps) {
+				return fmt.Errorf("`azure_devops_service_connection_id` can only be specified when `repository_type` is %q", string(sourcecontrols.RepoTypeDevOps))
+			}
+
+			contentTypes := make(map[string]struct{})
+			for _, v := range model.ContentTypes {
+				contentTypes[v] = struct{}{}
+			}
+
+			for _, repository := range model.Repository {
+				for _, mapping := range repository.PathMapping {
+					if mapping.ContentType == "" {
+						continue
+					}
+					if _, ok := contentTypes[mapping.ContentType]; !ok {
+						return fmt.Errorf("the `path_mapping` for the content type %q requires %q to be specified in `content_types`", mapping.ContentType, mapping.ContentType)
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SourceControlResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			var model SourceControlModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := sourcecontrols.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating UUID for Source Control: %+v", err)
+			}
+
+			id := sourcecontrols.NewSourceControlID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, name)
+
+			contentTypes := make([]sourcecontrols.ContentType, 0)
+			for _, v := range model.ContentTypes {
+				contentTypes = append(contentTypes, sourcecontrols.ContentType(v))
+			}
+
+			input := sourcecontrols.SourceControl{
+				Properties: &sourcecontrols.SourceControlProperties{
+					Id:                     utils.String(name),
+					DisplayName:            model.DisplayName,
+					RepoType:               sourcecontrols.RepoType(model.RepositoryType),
+					ContentTypes:           contentTypes,
+					Repository:             expandSentinelSourceControlRepository(model.Repository),
+					RepositoryResourceInfo: expandSentinelSourceControlRepositoryResourceInfo(model),
+				},
+			}
+			if model.Description != "" {
+				input.Properties.Description = utils.String(model.Description)
+			}
+
+			if _, err := client.Create(ctx, id, input); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SourceControlResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := sourcecontrols.ParseSourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			model := SourceControlModel{
+				WorkspaceId:  sourcecontrols.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ContentTypes: make([]string, 0),
+			}
+
+			if resp.Model != nil && resp.Model.Properties != nil {
+				props := resp.Model.Properties
+				model.DisplayName = props.DisplayName
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.RepositoryType = string(props.RepoType)
+				for _, v := range props.ContentTypes {
+					model.ContentTypes = append(model.ContentTypes, string(v))
+				}
+				model.Repository = flattenSentinelSourceControlRepository(props.Repository)
+
+				if info := props.RepositoryResourceInfo; info != nil {
+					if info.GitHubResourceInfo != nil {
+						model.GitHubAppInstallationId = utils.NormalizeNilableString(info.GitHubResourceInfo.AppInstallationId)
+					}
+					if info.AzureDevOpsResourceInfo != nil {
+						model.AzureDevOpsServiceConnectionId = utils.NormalizeNilableString(info.AzureDevOpsResourceInfo.ServiceConnectionId)
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r SourceControlResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.SourceControlsClient
+
+			id, err := sourcecontrols.ParseSourceControlID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSentinelSourceControlRepository(input []SourceControlRepositoryModel) sourcecontrols.Repository {
+	if len(input) == 0 {
+		return sourcecontrols.Repository{}
+	}
+
+	repository := input[0]
+	output := sourcecontrols.Repository{
+		Url: utils.String(repository.Url),
+	}
+	if repository.Branch != "" {
+		output.Branch = utils.String(repository.Branch)
+	}
+
+	if len(repository.PathMapping) > 0 {
+		mappings := make([]sourcecontrols.ContentPathMap, 0)
+		for _, v := range repository.PathMapping {
+			contentType := sourcecontrols.ContentType(v.ContentType)
+			mappings = append(mappings, sourcecontrols.ContentPathMap{
+				ContentType: &contentType,
+				Path:        utils.String(v.Path),
+			})
+		}
+		output.PathMapping = &mappings
+	}
+
+	return output
+}
+
+func flattenSentinelSourceControlRepository(input sourcecontrols.Repository) []SourceControlRepositoryModel {
+	output := SourceControlRepositoryModel{
+		Url:         utils.NormalizeNilableString(input.Url),
+		Branch:      utils.NormalizeNilableString(input.Branch),
+		DisplayUrl:  utils.NormalizeNilableString(input.DisplayUrl),
+		PathMapping: make([]SourceControlPathMappingModel, 0),
+	}
+
+	if input.PathMapping != nil {
+		for _, v := range *input.PathMapping {
+			mapping := SourceControlPathMappingModel{
+				Path: utils.NormalizeNilableString(v.Path),
+			}
+			if v.ContentType != nil {
+				mapping.ContentType = string(*v.ContentType)
+			}
+			output.PathMapping = append(output.PathMapping, mapping)
+		}
+	}
+
+	return []SourceControlRepositoryModel{output}
+}
+
+func expandSentinelSourceControlRepositoryResourceInfo(input SourceControlModel) *sourcecontrols.RepositoryResourceInfo {
+	if input.GitHubAppInstallationId != "" {
+		return &sourcecontrols.RepositoryResourceInfo{
+			GitHubResourceInfo: &sourcecontrols.GitHubResourceInfo{
+				AppInstallationId: utils.String(input.GitHubAppInstallationId),
+			},
+		}
+	}
+
+	if input.AzureDevOpsServiceConnectionId != "" {
+		return &sourcecontrols.RepositoryResourceInfo{
+			AzureDevOpsResourceInfo: &sourcecontrols.AzureDevOpsResourceInfo{
+				ServiceConnectionId: utils.String(input.AzureDevOpsServiceConnectionId),
+			},
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-10-01-preview/sourcecontrols"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SourceControlResource struct {
+	repositoryUrl     string
+	appInstallationId string
+}
+
+func TestAccSourceControl_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_source_control", "test")
+	r := newSourceControlResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSourceControl_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_source_control", "test")
+	r := newSourceControlResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("repository.0.path_mapping.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func newSourceControlResource(t *testing.T) SourceControlResource {
+	if os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL") == "" {
+		t.Skip("Skipping as ARM_TEST_SENTINEL_GITHUB_REPO_URL is not specified")
+	}
+	if os.Getenv("ARM_TEST_SENTINEL_GITHUB_APP_INSTALLATION_ID") == "" {
+		t.Skip("Skipping as ARM_TEST_SENTINEL_GITHUB_APP_INSTALLATION_ID is not specified")
+	}
+
+	return SourceControlResource{
+		repositoryUrl:     os.Getenv("ARM_TEST_SENTINEL_GITHUB_REPO_URL"),
+		appInstallationId: os.Getenv("ARM_TEST_SENTINEL_GITHUB_APP_INSTALLATION_ID"),
+	}
+}
+
+func (r SourceControlResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.SourceControlsClient
+
+	id, err := sourcecontrols.ParseSourceControlID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, *id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r SourceControlResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_source_control" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest-sc-%d"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule"]
+
+  repository {
+    url    = %q
+    branch = "main"
+  }
+
+  github_app_installation_id = %q
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger, r.repositoryUrl, r.appInstallationId)
+}
+
+func (r SourceControlResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_source_control" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest-sc-%d"
+  description                = "Deploys Sentinel content from GitHub"
+  repository_type            = "Github"
+  content_types              = ["AnalyticRule", "Workbook"]
+
+  repository {
+    url    = %q
+    branch = "main"
+
+    path_mapping {
+      content_type = "AnalyticRule"
+      path         = "analytics"
+    }
+
+    path_mapping {
+      content_type = "Workbook"
+      path         = "workbooks"
+    }
+  }
+
+  github_app_installation_id = %q
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger, r.repositoryUrl, r.appInstallationId)
+}
+
+func (r SourceControlResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,532 @@
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	securityinsight "github.com/tombuildsstuff/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
+)
+
+// the Threat Intelligence Indicators within a Workspace are always nested beneath the `main` Threat Intelligence resource
+const threatIntelligenceName = "main"
+
+type ThreatIntelligenceIndicatorModel struct {
+	WorkspaceId        string                                     `tfschema:"log_analytics_workspace_id"`
+	DisplayName        string                                     `tfschema:"display_name"`
+	PatternType        string                                     `tfschema:"pattern_type"`
+	Pattern            string                                     `tfschema:"pattern"`
+	PatternVersion     string                                     `tfschema:"pattern_version"`
+	Source             string                                     `tfschema:"source"`
+	ValidateFromUtc    string                                     `tfschema:"validate_from_utc"`
+	ValidateUntilUtc   string                                     `tfschema:"validate_until_utc"`
+	Confidence         int64                                      `tfschema:"confidence"`
+	CreatedBy          string                                     `tfschema:"created_by"`
+	Description        string                                     `tfschema:"description"`
+	Language           string                                     `tfschema:"language"`
+	Revoked            bool                                       `tfschema:"revoked"`
+	Tags               []string                                   `tfschema:"tags"`
+	ThreatTypes        []string                                   `tfschema:"threat_types"`
+	KillChainPhases    []ThreatIntelligenceKillChainPhaseModel    `tfschema:"kill_chain_phase"`
+	ExternalReferences []ThreatIntelligenceExternalReferenceModel `tfschema:"external_reference"`
+	Guid               string                                     `tfschema:"guid"`
+	CreatedOn          string                                     `tfschema:"created_on"`
+	LastUpdatedTimeUtc string                                     `tfschema:"last_updated_time_utc"`
+	Defanged           bool                                       `tfschema:"defanged"`
+}
+
+type ThreatIntelligenceKillChainPhaseModel struct {
+	KillChainName string `tfschema:"kill_chain_name"`
+	PhaseName     string `tfschema:"phase_name"`
+}
+
+type ThreatIntelligenceExternalReferenceModel struct {
+	SourceName  string `tfschema:"source_name"`
+	Description string `tfschema:"description"`
+	ExternalId  string `tfschema:"external_id"`
+	Url         string `tfschema:"url"`
+}
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ThreatIntelligenceIndicatorResource{}
+	_ sdk.ResourceWithCustomizeDiff = ThreatIntelligenceIndicatorResource{}
+)
+
+func (r ThreatIntelligenceIndicatorResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"display_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"pattern_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"domain-name",
+				"file",
+				"ipv4-addr",
+				"ipv6-addr",
+				"url",
+			}, false),
+		},
+
+		"pattern": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^\[.+\]$`),
+				"`pattern` must be a STIX pattern enclosed in square brackets, for example `[ipv4-addr:value = '198.51.100.1']`",
+			),
+		},
+
+		"pattern_version": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "2.1",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      "Microsoft Sentinel",
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"validate_from_utc": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"validate_until_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"confidence": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      -1,
+			ValidateFunc: validation.IntBetween(-1, 100),
+		},
+
+		"created_by": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"language": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"revoked": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"threat_types": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"kill_chain_phase": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"kill_chain_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"phase_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"external_reference": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"source_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"description": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"external_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"url": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"created_on": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"last_updated_time_utc": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"defanged": {
+			Type:     pluginsdk.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) ResourceType() string {
+	return "azurerm_sentinel_threat_intelligence_indicator"
+}
+
+func (r ThreatIntelligenceIndicatorResource) ModelObject() interface{} {
+	return &ThreatIntelligenceIndicatorModel{}
+}
+
+func (r ThreatIntelligenceIndicatorResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ThreatIntelligenceIndicatorID
+}
+
+func (r ThreatIntelligenceIndicatorResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if model.ValidateFromUtc == "" || model.ValidateUntilUtc == "" {
+				return nil
+			}
+
+			// the values may not be known until apply
+			validFrom, err := time.Parse(time.RFC3339, model.ValidateFromUtc)
+			if err != nil {
+				return nil
+			}
+			validUntil, err := time.Parse(time.RFC3339, model.ValidateUntilUtc)
+			if err != nil {
+				return nil
+			}
+
+			if !validUntil.After(validFrom) {
+				return fmt.Errorf("`validate_until_utc` must be later than `validate_from_utc`")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			// the name of an Indicator is a generated GUID, so there's no need to check for an existing Indicator
+			name, err := uuid.GenerateUUID()
+			if err != nil {
+				return fmt.Errorf("generating the name for the Threat Intelligence Indicator: %+v", err)
+			}
+
+			id := parse.NewThreatIntelligenceIndicatorID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, threatIntelligenceName, name)
+
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, expandThreatIntelligenceIndicatorModel(model)); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if resp.Value == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+			indicator, ok := resp.Value.AsThreatIntelligenceIndicatorModel()
+			if !ok {
+				return fmt.Errorf("retrieving %s: expected a Threat Intelligence Indicator", id)
+			}
+
+			model := ThreatIntelligenceIndicatorModel{
+				WorkspaceId:        workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroup, id.WorkspaceName).ID(),
+				Guid:               id.IndicatorName,
+				Tags:               make([]string, 0),
+				ThreatTypes:        make([]string, 0),
+				KillChainPhases:    make([]ThreatIntelligenceKillChainPhaseModel, 0),
+				ExternalReferences: make([]ThreatIntelligenceExternalReferenceModel, 0),
+			}
+
+			if props := indicator.ThreatIntelligenceIndicatorProperties; props != nil {
+				model.DisplayName = utils.NormalizeNilableString(props.DisplayName)
+				model.Pattern = utils.NormalizeNilableString(props.Pattern)
+				model.PatternType = utils.NormalizeNilableString(props.PatternType)
+				model.PatternVersion = utils.NormalizeNilableString(props.PatternVersion)
+				model.Source = utils.NormalizeNilableString(props.Source)
+				model.ValidateFromUtc = utils.NormalizeNilableString(props.ValidFrom)
+				model.ValidateUntilUtc = utils.NormalizeNilableString(props.ValidUntil)
+				model.CreatedBy = utils.NormalizeNilableString(props.CreatedByRef)
+				model.Description = utils.NormalizeNilableString(props.Description)
+				model.Language = utils.NormalizeNilableString(props.Language)
+				model.CreatedOn = utils.NormalizeNilableString(props.Created)
+				model.LastUpdatedTimeUtc = utils.NormalizeNilableString(props.LastUpdatedTimeUtc)
+
+				model.Confidence = -1
+				if props.Confidence != nil {
+					model.Confidence = int64(*props.Confidence)
+				}
+				if props.Revoked != nil {
+					model.Revoked = *props.Revoked
+				}
+				if props.Defanged != nil {
+					model.Defanged = *props.Defanged
+				}
+				if props.ThreatIntelligenceTags != nil {
+					model.Tags = *props.ThreatIntelligenceTags
+				}
+				if props.ThreatTypes != nil {
+					model.ThreatTypes = *props.ThreatTypes
+				}
+
+				if props.KillChainPhases != nil {
+					for _, v := range *props.KillChainPhases {
+						model.KillChainPhases = append(model.KillChainPhases, ThreatIntelligenceKillChainPhaseModel{
+							KillChainName: utils.NormalizeNilableString(v.KillChainName),
+							PhaseName:     utils.NormalizeNilableString(v.PhaseName),
+						})
+					}
+				}
+
+				if props.ExternalReferences != nil {
+					for _, v := range *props.ExternalReferences {
+						model.ExternalReferences = append(model.ExternalReferences, ThreatIntelligenceExternalReferenceModel{
+							SourceName:  utils.NormalizeNilableString(v.SourceName),
+							Description: utils.NormalizeNilableString(v.Description),
+							ExternalId:  utils.NormalizeNilableString(v.ExternalID),
+							Url:         utils.NormalizeNilableString(v.URL),
+						})
+					}
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ThreatIntelligenceIndicatorModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			// the API replaces the whole Indicator, so the complete model is sent
+			if _, err := client.Create(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName, expandThreatIntelligenceIndicatorModel(model)); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ThreatIntelligenceIndicatorResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ThreatIntelligenceClient
+
+			id, err := parse.ThreatIntelligenceIndicatorID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandThreatIntelligenceIndicatorModel(input ThreatIntelligenceIndicatorModel) securityinsight.ThreatIntelligenceIndicatorModel {
+	props := securityinsight.ThreatIntelligenceIndicatorProperties{
+		DisplayName:            utils.String(input.DisplayName),
+		Pattern:                utils.String(input.Pattern),
+		PatternType:            utils.String(input.PatternType),
+		PatternVersion:         utils.String(input.PatternVersion),
+		Source:                 utils.String(input.Source),
+		ValidFrom:              utils.String(input.ValidateFromUtc),
+		Revoked:                utils.Bool(input.Revoked),
+		ThreatIntelligenceTags: &input.Tags,
+		ThreatTypes:            &input.ThreatTypes,
+	}
+
+	if input.ValidateUntilUtc != "" {
+		props.ValidUntil = utils.String(input.ValidateUntilUtc)
+	}
+	if input.Confidence != -1 {
+		props.Confidence = utils.Int32(int32(input.Confidence))
+	}
+	if input.CreatedBy != "" {
+		props.CreatedByRef = utils.String(input.CreatedBy)
+	}
+	if input.Description != "" {
+		props.Description = utils.String(input.Description)
+	}
+	if input.Language != "" {
+		props.Language = utils.String(input.Language)
+	}
+
+	killChainPhases := make([]securityinsight.ThreatIntelligenceKillChainPhase, 0)
+	for _, v := range input.KillChainPhases {
+		killChainPhases = append(killChainPhases, securityinsight.ThreatIntelligenceKillChainPhase{
+			KillChainName: utils.String(v.KillChainName),
+			PhaseName:     utils.String(v.PhaseName),
+		})
+	}
+	props.KillChainPhases = &killChainPhases
+
+	externalReferences := make([]securityinsight.ThreatIntelligenceExternalReference, 0)
+	for _, v := range input.ExternalReferences {
+		reference := securityinsight.ThreatIntelligenceExternalReference{
+			SourceName: utils.String(v.SourceName),
+		}
+		if v.Description != "" {
+			reference.Description = utils.String(v.Description)
+		}
+		if v.ExternalId != "" {
+			reference.ExternalID = utils.String(v.ExternalId)
+		}
+		if v.Url != "" {
+			reference.URL = utils.String(v.Url)
+		}
+		externalReferences = append(externalReferences, reference)
+	}
+	props.ExternalReferences = &externalReferences
+
+	return securityinsight.ThreatIntelligenceIndicatorModel{
+		Kind:                                  securityinsight.KindBasicThreatIntelligenceInformationKindIndicator,
+		ThreatIntelligenceIndicatorProperties: &props,
+	}
+}
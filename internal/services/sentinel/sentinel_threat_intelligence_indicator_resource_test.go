@@ -0,0 +1,170 @@
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sentinel/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ThreatIntelligenceIndicatorResource struct{}
+
+func TestAccThreatIntelligenceIndicator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("guid").IsUUID(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccThreatIntelligenceIndicator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_threat_intelligence_indicator", "test")
+	r := ThreatIntelligenceIndicatorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ThreatIntelligenceIndicatorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.ThreatIntelligenceClient
+
+	id, err := parse.ThreatIntelligenceIndicatorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp, err := client.Get(ctx, id.ResourceGroup, id.WorkspaceName, id.IndicatorName); err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r ThreatIntelligenceIndicatorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest-indicator-%d"
+  pattern_type               = "ipv4-addr"
+  pattern                    = "[ipv4-addr:value = '198.51.100.1']"
+  validate_from_utc          = "2023-01-01T00:00:00Z"
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_threat_intelligence_indicator" "test" {
+  log_analytics_workspace_id = azurerm_log_analytics_workspace.test.id
+  display_name               = "acctest-indicator-%d"
+  description                = "an example indicator"
+  pattern_type               = "domain-name"
+  pattern                    = "[domain-name:value = 'example.com']"
+  source                     = "acctest"
+  validate_from_utc          = "2023-01-01T00:00:00Z"
+  validate_until_utc         = "2123-01-01T00:00:00Z"
+  confidence                 = 80
+  language                   = "en"
+  revoked                    = true
+  tags                       = ["test-tag"]
+  threat_types               = ["malicious-activity"]
+
+  kill_chain_phase {
+    kill_chain_name = "lockheed-martin-cyber-kill-chain"
+    phase_name      = "reconnaissance"
+  }
+
+  external_reference {
+    source_name = "acctest"
+    description = "an example reference"
+    url         = "https://example.com/indicator"
+  }
+
+  depends_on = [azurerm_sentinel_log_analytics_workspace_onboarding.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ThreatIntelligenceIndicatorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-sentinel-%d"
+  location = %q
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctest-workspace-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_sentinel_log_analytics_workspace_onboarding" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  workspace_name      = azurerm_log_analytics_workspace.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
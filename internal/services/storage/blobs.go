@@ -3,10 +3,13 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5" // nolint: gosec
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"runtime"
 	"strings"
@@ -151,20 +154,81 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
-	input := blobs.PutBlockBlobInput{
-		ContentType: utils.String(sbu.ContentType),
-		MetaData:    sbu.MetaData,
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	blockList, contentMD5, err := sbu.storageBlobBlockSplit(file, info.Size())
+	if err != nil {
+		return fmt.Errorf("splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	// the Block IDs are derived from the contents of each block, as such any block which is already committed
+	// to the blob is unchanged and doesn't need to be uploaded again. Since the file is split into fixed-size
+	// blocks, inserting or removing data shifts the contents of every subsequent block, which are re-uploaded
+	committedBlocks, err := sbu.committedBlockIDs(ctx)
+	if err != nil {
+		return err
 	}
+
+	blockIDs := make([]blobs.BlockID, 0, len(blockList))
+	pendingBlocks := make([]storageBlobBlock, 0)
+	for _, block := range blockList {
+		blockIDs = append(blockIDs, blobs.BlockID{Value: block.id})
+		if _, exists := committedBlocks[block.id]; exists {
+			continue
+		}
+		pendingBlocks = append(pendingBlocks, block)
+	}
+	log.Printf("[DEBUG] Uploading %d of %d blocks for Blob %q (Container %q / Account %q)..", len(pendingBlocks), len(blockList), sbu.BlobName, sbu.ContainerName, sbu.AccountName)
+
+	if err := sbu.blockUploadFromSource(ctx, pendingBlocks); err != nil {
+		return err
+	}
+
+	// when a Block Blob is uploaded in a single request Azure computes the MD5 of the contents, however when
+	// the block list is committed this needs to be specified, so we compute this if it's not been specified
 	if sbu.ContentMD5 != "" {
-		input.ContentMD5 = utils.String(sbu.ContentMD5)
+		contentMD5 = sbu.ContentMD5
+	}
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIDs,
+		},
+		CacheControl: utils.String(sbu.CacheControl),
+		ContentMD5:   utils.String(contentMD5),
+		ContentType:  utils.String(sbu.ContentType),
+		MetaData:     sbu.MetaData,
 	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("PutBlockBlobFromFile: %s", err)
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
 	}
 
 	return nil
 }
 
+func (sbu BlobUpload) committedBlockIDs(ctx context.Context) (map[string]struct{}, error) {
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Committed,
+	}
+	resp, err := sbu.Client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return map[string]struct{}{}, nil
+		}
+
+		return nil, fmt.Errorf("GetBlockList: %s", err)
+	}
+
+	blockIDs := make(map[string]struct{}, len(resp.CommittedBlocks.Blocks))
+	for _, block := range resp.CommittedBlocks.Blocks {
+		blockIDs[block.Name] = struct{}{}
+	}
+
+	return blockIDs, nil
+}
+
 func (sbu BlobUpload) createEmptyPageBlob(ctx context.Context) error {
 	if sbu.Size == 0 {
 		return fmt.Errorf("`size` cannot be zero for a page blob")
@@ -364,6 +428,123 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+type storageBlobBlock struct {
+	id      string
+	section *io.SectionReader
+}
+
+// 4MB blocks allow Block Blobs of up to ~195GB, given a blob can contain at most 50,000 blocks
+const blockSize int64 = 4 * 1024 * 1024
+
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64) ([]storageBlobBlock, string, error) {
+	contentHash := md5.New()
+
+	var blocks []storageBlobBlock
+	for offset := int64(0); offset < fileSize; offset += blockSize {
+		section := io.NewSectionReader(file, offset, blockSize)
+
+		blockHash := md5.New()
+		if _, err := io.Copy(io.MultiWriter(blockHash, contentHash), section); err != nil {
+			return nil, "", fmt.Errorf("Could not read chunk at %d: %s", offset, err)
+		}
+
+		// Block IDs must be the same length for all blocks within a blob, which the hex-encoded MD5 always is -
+		// a block list can reference the same block more than once, so identical blocks share an ID
+		blockID := hex.EncodeToString(blockHash.Sum(nil))
+		blocks = append(blocks, storageBlobBlock{
+			id:      base64.StdEncoding.EncodeToString([]byte(blockID)),
+			section: io.NewSectionReader(file, offset, section.Size()),
+		})
+	}
+
+	return blocks, base64.StdEncoding.EncodeToString(contentHash.Sum(nil)), nil
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, blockList []storageBlobBlock) error {
+	if len(blockList) == 0 {
+		return nil
+	}
+
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(blockList))
+
+	for _, block := range blockList {
+		blocks <- block
+	}
+	close(blocks)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blocks, errors, wg)
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, blocks chan storageBlobBlock, errors chan error, wg *sync.WaitGroup) {
+	for block := range blocks {
+		chunk := make([]byte, block.section.Size())
+		if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+			errors <- fmt.Errorf("reading source file %q: %s", sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: chunk,
+		}
+		if _, err := sbu.Client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			errors <- fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+			wg.Done()
+			continue
+		}
+
+		wg.Done()
+	}
+}
+
+// sourceFileHash returns the hex-encoded SHA256 hash of the contents of the file at `path`
+func sourceFileHash(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// sourceFileMD5 returns the hex-encoded MD5 hash of the contents of the file at `path`, as used for `content_md5`
+func sourceFileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() // nolint: gosec
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
@@ -1,8 +1,10 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
@@ -111,6 +113,11 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
+			"source_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"source_content": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
@@ -125,10 +132,12 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ConflictsWith: []string{"source", "source_content"},
 			},
 
+			// NOTE: changing this forces a new resource, unless the contents of `source` are being re-uploaded
+			// in-place, which is handled in the CustomizeDiff
 			"content_md5": {
 				Type:          pluginsdk.TypeString,
 				Optional:      true,
-				ForceNew:      true,
+				Computed:      true,
 				ConflictsWith: []string{"source_uri"},
 			},
 
@@ -138,7 +147,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -148,9 +156,89 @@ func resourceStorageBlob() *pluginsdk.Resource {
 
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageBlobCustomizeDiff),
 	}
 }
 
+func resourceStorageBlobCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	reupload, err := resourceStorageBlobCustomizeDiffSource(d)
+	if err != nil {
+		return err
+	}
+
+	// `content_md5` describes the uploaded contents, so is only updated in-place when the contents are re-uploaded
+	if !reupload && d.Id() != "" && d.HasChange("content_md5") {
+		return d.ForceNew("content_md5")
+	}
+
+	return nil
+}
+
+// resourceStorageBlobCustomizeDiffSource detects changes to the contents of `source`, returning whether the
+// contents of the Blob will be re-uploaded in-place
+func resourceStorageBlobCustomizeDiffSource(d *pluginsdk.ResourceDiff) (bool, error) {
+	source := d.Get("source").(string)
+	if source == "" {
+		return false, nil
+	}
+
+	// Blobs created before `source_hash` existed have no hash in the state - rather than assuming the contents
+	// have changed and re-uploading them, the hash is seeded by the next refresh
+	oldHash, _ := d.GetChange("source_hash")
+	if d.Id() != "" && oldHash.(string) == "" {
+		return false, nil
+	}
+
+	// the file may be created by another resource during the apply, in which case the hash is only known once it's uploaded
+	hash, err := sourceFileHash(source)
+	if err != nil && !os.IsNotExist(err) {
+		return false, fmt.Errorf("computing the hash of `source` %q: %+v", source, err)
+	}
+	sourceExists := err == nil
+
+	if sourceExists {
+		if oldHash.(string) == hash {
+			return false, nil
+		}
+
+		if err := d.SetNew("source_hash", hash); err != nil {
+			return false, fmt.Errorf("setting `source_hash`: %+v", err)
+		}
+	} else if err := d.SetNewComputed("source_hash"); err != nil {
+		return false, fmt.Errorf("setting `source_hash`: %+v", err)
+	}
+
+	if d.Id() == "" {
+		return false, nil
+	}
+
+	// only the blocks within a Block blob can be re-uploaded in-place, other types of blob need to be recreated
+	if !strings.EqualFold(d.Get("type").(string), "Block") {
+		return false, d.ForceNew("source_hash")
+	}
+
+	// the `content_md5` is recomputed from the new contents when they're re-uploaded, as such any value which has
+	// been specified must match the new contents rather than those previously uploaded
+	contentMD5 := d.GetRawConfig().GetAttr("content_md5")
+	if contentMD5.IsNull() {
+		return true, d.SetNewComputed("content_md5")
+	}
+
+	if contentMD5.IsKnown() && sourceExists {
+		sourceMD5, err := sourceFileMD5(source)
+		if err != nil {
+			return false, fmt.Errorf("computing the MD5 of `source` %q: %+v", source, err)
+		}
+
+		if !strings.EqualFold(contentMD5.AsString(), sourceMD5) {
+			return false, fmt.Errorf("`content_md5` must be updated to %q to match the new contents of `source` %q", sourceMD5, source)
+		}
+	}
+
+	return true, nil
+}
+
 func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -223,6 +311,14 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.SetId(id)
 
+	if source := d.Get("source").(string); source != "" {
+		hash, err := sourceFileHash(source)
+		if err != nil {
+			return fmt.Errorf("computing the hash of `source` %q: %+v", source, err)
+		}
+		d.Set("source_hash", hash)
+	}
+
 	return resourceStorageBlobUpdate(d, meta)
 }
 
@@ -249,6 +345,36 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("building Blobs Client: %s", err)
 	}
 
+	if !d.IsNewResource() && d.HasChange("source_hash") {
+		// the Block IDs are derived from the contents of each block, so blocks which are already committed aren't uploaded again
+		log.Printf("[DEBUG] Re-uploading the contents of Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		// `content_md5` isn't specified since it described the previous contents, instead it's computed from the new contents
+		source := d.Get("source").(string)
+		blobInput := BlobUpload{
+			AccountName:   id.AccountName,
+			ContainerName: id.ContainerName,
+			BlobName:      id.BlobName,
+			Client:        blobsClient,
+
+			BlobType:     d.Get("type").(string),
+			CacheControl: d.Get("cache_control").(string),
+			ContentType:  d.Get("content_type").(string),
+			MetaData:     ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+			Parallelism:  d.Get("parallelism").(int),
+			Source:       source,
+		}
+		if err := blobInput.uploadBlockBlob(ctx); err != nil {
+			return fmt.Errorf("re-uploading the contents of Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+
+		hash, err := sourceFileHash(source)
+		if err != nil {
+			return fmt.Errorf("computing the hash of `source` %q: %+v", source, err)
+		}
+		d.Set("source_hash", hash)
+		log.Printf("[DEBUG] Re-uploaded the contents of Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	}
+
 	if d.HasChange("access_tier") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
@@ -268,8 +394,23 @@ func resourceStorageBlobUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			CacheControl: utils.String(d.Get("cache_control").(string)),
 		}
 
-		// `content_md5` is `ForceNew` but must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
-		if contentMD5 := d.Get("content_md5").(string); contentMD5 != "" {
+		// `content_md5` must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob - however
+		// when the contents have just been re-uploaded the value in the state describes the previous contents, so is re-read
+		contentMD5 := d.Get("content_md5").(string)
+		if d.HasChange("source_hash") {
+			props, err := blobsClient.GetProperties(ctx, id.AccountName, id.ContainerName, id.BlobName, blobs.GetPropertiesInput{})
+			if err != nil {
+				return fmt.Errorf("retrieving properties for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+			}
+
+			contentMD5 = ""
+			if props.ContentMD5 != "" {
+				if contentMD5, err = convertBase64ToHexEncoding(props.ContentMD5); err != nil {
+					return fmt.Errorf("converting base64 to hex encoding for content_md5: %s", err)
+				}
+			}
+		}
+		if contentMD5 != "" {
 			data, err := convertHexToBase64Encoding(contentMD5)
 			if err != nil {
 				return fmt.Errorf("in converting hex to base64 encoding for content_md5: %s", err)
@@ -367,6 +508,16 @@ func resourceStorageBlobRead(d *pluginsdk.ResourceData, meta interface{}) error
 		d.Set("source_uri", props.CopySource)
 	}
 
+	// Blobs created before `source_hash` existed have no hash in the state, so it's seeded from the current contents of `source`
+	if source := d.Get("source").(string); source != "" && d.Get("source_hash").(string) == "" {
+		hash, err := sourceFileHash(source)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to compute the hash of `source` %q for Blob %q (Container %q / Account %q), skipping: %+v", source, id.BlobName, id.ContainerName, id.AccountName, err)
+		} else {
+			d.Set("source_hash", hash)
+		}
+	}
+
 	return nil
 }
 
@@ -214,7 +214,45 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileUpdated(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("source_hash").Exists(),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
+		{
+			PreConfig: func() {
+				if err := updateTempFile(sourceBlob.Name()); err != nil {
+					t.Fatalf("Error updating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlob(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
 	})
 }
 
@@ -239,7 +277,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("parallelism", "size", "source", "source_hash", "type"),
 	})
 }
 
@@ -382,7 +420,7 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStep("parallelism", "size", "type", "source", "source_hash"),
 	})
 }
 
@@ -1134,3 +1172,23 @@ func populateTempFile(input *os.File) error {
 
 	return nil
 }
+
+// updateTempFile overwrites a 1MB section in the middle of the file, so that only a single block changes
+func updateTempFile(path string) error {
+	file, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return fmt.Errorf("Failed to open file %q: %s", path, err)
+	}
+	defer file.Close()
+
+	randomBytes := make([]byte, 1*1024*1024)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return fmt.Errorf("Failed to read random bytes")
+	}
+
+	if _, err := file.WriteAt(randomBytes, 9*1024*1024); err != nil {
+		return fmt.Errorf("Failed to write random bytes to file")
+	}
+
+	return nil
+}